@@ -0,0 +1,54 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetAccount gets current account information
+func (c *Client) GetAccount() (*Account, error) {
+	return c.GetAccountContext(context.Background())
+}
+
+// GetAccountContext is like GetAccount but uses ctx for the request
+func (c *Client) GetAccountContext(ctx context.Context) (*Account, error) {
+	r := &request{method: http.MethodGet, path: addrAccount, sec: secSigned}
+	account := new(Account)
+	if err := c.do(ctx, r, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// GetMyTrades gets trades of the account on a symbol
+func (c *Client) GetMyTrades(req *MyTradesRequest) ([]AccountTrade, error) {
+	return c.GetMyTradesContext(context.Background(), req)
+}
+
+func (r *MyTradesRequest) validate() error {
+	if r.Symbol == "" {
+		return errMissingSymbol
+	}
+	if r.FromID != 0 && (!r.StartTime.IsZero() || !r.EndTime.IsZero()) {
+		return errFromIDWithTimeRange
+	}
+	return nil
+}
+
+// GetMyTradesContext is like GetMyTrades but uses ctx for the request
+func (c *Client) GetMyTradesContext(ctx context.Context, req *MyTradesRequest) (list []AccountTrade, err error) {
+	if err = req.validate(); err != nil {
+		return nil, err
+	}
+	p := params{
+		"symbol":    req.Symbol,
+		"orderId":   formatInt(req.OrderID),
+		"startTime": formatTime(req.StartTime),
+		"endTime":   formatTime(req.EndTime),
+		"fromId":    formatInt(req.FromID),
+		"limit":     formatInt(int64(req.Limit)),
+	}
+	r := &request{method: http.MethodGet, path: addrMyTrades, params: p, sec: secSigned}
+	err = c.do(ctx, r, &list)
+	return
+}
@@ -1,43 +1,92 @@
 package binance
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 )
 
 // Ping tests connection to the Rest API
-func Ping() error {
-	return fetch(addrPing, nil, nil)
+func (c *Client) Ping() error {
+	return c.PingContext(context.Background())
+}
+
+// PingContext is like Ping but uses ctx for the request
+func (c *Client) PingContext(ctx context.Context) error {
+	return c.fetch(ctx, addrPing, nil, nil)
 }
 
 // GetServerTime gets Binance server time
-func GetServerTime() (t *time.Time, err error) {
+func (c *Client) GetServerTime() (t *time.Time, err error) {
+	return c.GetServerTimeContext(context.Background())
+}
+
+// GetServerTimeContext is like GetServerTime but uses ctx for the request
+func (c *Client) GetServerTimeContext(ctx context.Context) (t *time.Time, err error) {
 	var reply struct{ ServerTime int64 }
-	if err := fetch(addrServerTime, nil, &reply); err != nil {
+	if err := c.fetch(ctx, addrServerTime, nil, &reply); err != nil {
 		return nil, err
 	}
 	tval := time.Unix(0, reply.ServerTime*int64(time.Millisecond))
 	return &tval, nil
 }
 
-// GetExchangeInfo gets trade information for all symbols
-func GetExchangeInfo() (info *ExchangeInfo, err error) {
-	err = fetch(addrExchangeInfo, nil, &info)
-	return
+// GetExchangeInfo gets trade information for all symbols.
+// Rate limits of the response are used as limits of the client rate limit usage.
+func (c *Client) GetExchangeInfo() (info *ExchangeInfo, err error) {
+	return c.GetExchangeInfoContext(context.Background())
+}
+
+// GetExchangeInfoContext is like GetExchangeInfo but uses ctx for the request
+func (c *Client) GetExchangeInfoContext(ctx context.Context) (info *ExchangeInfo, err error) {
+	if err = c.fetch(ctx, addrExchangeInfo, nil, &info); err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, errors.New("binance: empty exchange info response")
+	}
+	c.usage.setLimits(info.RateLimits)
+	return info, nil
 }
 
 // GetOrderBook gets orders for given symbol.
+// Limit must be between 5 and 5000, or empty for 100 levels.
 // Weight is adjusted based on the limit where
-// [Limit 5, 10, 20, 50, 100] = [Weight 1];
-// [Limit 500] = [Weight 5];
-// [Limit 1000] = [Weight 10]
-func GetOrderBook(symbol, limit string) (*OrderBook, error) {
+// [Limit 5-100] = [Weight 5];
+// [Limit 101-500] = [Weight 25];
+// [Limit 501-1000] = [Weight 50];
+// [Limit 1001-5000] = [Weight 250]
+func (c *Client) GetOrderBook(symbol, limit string) (*OrderBook, error) {
+	return c.GetOrderBookContext(context.Background(), symbol, limit)
+}
+
+// GetOrderBookContext is like GetOrderBook but uses ctx for the request
+func (c *Client) GetOrderBookContext(ctx context.Context, symbol, limit string) (*OrderBook, error) {
+	if err := validateOrderBook(symbol, limit); err != nil {
+		return nil, err
+	}
+	return c.fetchOrderBook(ctx, symbol, limit)
+}
+
+// validateOrderBook parses order book parameters and validates them as OrderBookRequest
+func validateOrderBook(symbol, limit string) error {
+	req := &OrderBookRequest{Symbol: symbol}
+	if limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			return fmt.Errorf("binance: invalid limit %q", limit)
+		}
+		req.Limit = n
+	}
+	return req.validate()
+}
+
+func (c *Client) fetchOrderBook(ctx context.Context, symbol, limit string) (*OrderBook, error) {
 	r := new(rawOrderBook)
 	p := params{"symbol": symbol, "limit": limit}
-	if err := fetch(addrOrderBook, p, r); err != nil {
+	if err := c.fetch(ctx, addrOrderBook, p, r); err != nil {
 		return nil, err
 	}
 	b, err := parseOrderBook(r)
@@ -49,194 +98,269 @@ func GetOrderBook(symbol, limit string) (*OrderBook, error) {
 }
 
 // GetRecentTrades gets up to 500 trades
-func GetRecentTrades(symbol, limit string) (list []Trade, err error) {
+func (c *Client) GetRecentTrades(symbol, limit string) (list []Trade, err error) {
+	return c.GetRecentTradesContext(context.Background(), symbol, limit)
+}
+
+// GetRecentTradesContext is like GetRecentTrades but uses ctx for the request
+func (c *Client) GetRecentTradesContext(ctx context.Context, symbol, limit string) (list []Trade, err error) {
 	p := params{"symbol": symbol, "limit": limit}
-	err = fetch(addrRecentTradesList, p, &list)
+	err = c.fetch(ctx, addrRecentTradesList, p, &list)
 	return
 }
 
 // GetOldTrades gets up to 500 older trades
-func GetOldTrades(symbol, limit, fromID string) (list []Trade, err error) {
+func (c *Client) GetOldTrades(symbol, limit, fromID string) (list []Trade, err error) {
+	return c.GetOldTradesContext(context.Background(), symbol, limit, fromID)
+}
+
+// GetOldTradesContext is like GetOldTrades but uses ctx for the request
+func (c *Client) GetOldTradesContext(ctx context.Context, symbol, limit, fromID string) (list []Trade, err error) {
 	p := params{"symbol": symbol, "limit": limit, "fromId": fromID}
-	err = fetch(addrOldTradeLookup, p, &list)
+	err = c.fetch(ctx, addrOldTradeLookup, p, &list)
 	return
 }
 
 // GetAggregateTrades get compressed, aggregate trades.
 // Trades that fill at the time, from the same order,
 // with the same price will have the quantity aggregated.
-func GetAggregateTrades(symbol, limit, fromID, startTime, endTime string) (list []AggregateTrade, err error) {
-	p := params{"symbol": symbol, "limit": limit, "fromId": fromID, "startTime": startTime, "endTime": endTime}
-	err = fetch(addrOldTradeLookup, p, &list)
-	return
+// Time between startTime and endTime must be less than one hour.
+func (c *Client) GetAggregateTrades(symbol, limit, fromID, startTime, endTime string) (list []AggregateTrade, err error) {
+	return c.GetAggregateTradesContext(context.Background(), symbol, limit, fromID, startTime, endTime)
 }
 
-// GetKlines gets lline/candlestick bars for a symbol.
-func GetKlines(symbol, interval, limit, startTime, endTime string) ([]Kline, error) {
-	var reply [][]interface{}
-	p := params{"symbol": symbol, "interval": interval, "limit": limit, "startTime": startTime, "endTime": endTime}
-	err := fetch(addrKlineCandlestickData, p, &reply)
-
-	if err != nil {
+// GetAggregateTradesContext is like GetAggregateTrades but uses ctx for the request
+func (c *Client) GetAggregateTradesContext(ctx context.Context, symbol, limit, fromID, startTime, endTime string) (list []AggregateTrade, err error) {
+	if err = validateAggregateTrades(symbol, fromID, startTime, endTime); err != nil {
 		return nil, err
 	}
+	p := params{"symbol": symbol, "limit": limit, "fromId": fromID, "startTime": startTime, "endTime": endTime}
+	err = c.fetch(ctx, addrAggregatedTrades, p, &list)
+	return
+}
 
-	list := make([]Kline, len(reply))
-	for i, v := range reply {
-		var kline Kline
-		var err error
-
-		t, ok := v[0].(float64)
-		if !ok {
-			return nil, errors.New("Invalid open time")
-		}
-		kline.OpenTime = time.Unix(int64(t), 0)
-
-		if kline.Open, err = strconv.ParseFloat(v[1].(string), 64); err != nil {
-			return nil, err
-		}
-		if kline.High, err = strconv.ParseFloat(v[2].(string), 64); err != nil {
-			return nil, err
-		}
-		if kline.Low, err = strconv.ParseFloat(v[3].(string), 64); err != nil {
-			return nil, err
-		}
-		if kline.Close, err = strconv.ParseFloat(v[4].(string), 64); err != nil {
-			return nil, err
-		}
-		if kline.Volume, err = strconv.ParseFloat(v[5].(string), 64); err != nil {
-			return nil, err
-		}
+// maxAggregateTradesRange is the limit of time range of aggregate trades request
+const maxAggregateTradesRange = time.Hour
 
-		t, ok = v[6].(float64)
-		if !ok {
-			return nil, errors.New("Invalid close time")
+// validateAggregateTrades parses aggregate trades parameters and validates them as AggregateTradesRequest
+func validateAggregateTrades(symbol, fromID, startTime, endTime string) error {
+	req := &AggregateTradesRequest{Symbol: symbol}
+	var err error
+	if fromID != "" {
+		if req.FromID, err = strconv.ParseInt(fromID, 10, 64); err != nil {
+			return fmt.Errorf("binance: invalid fromId %q", fromID)
 		}
-		kline.CloseTime = time.Unix(int64(t), 0)
+	}
+	if req.StartTime, err = parseTimeParam(startTime); err != nil {
+		return fmt.Errorf("binance: invalid startTime %q", startTime)
+	}
+	if req.EndTime, err = parseTimeParam(endTime); err != nil {
+		return fmt.Errorf("binance: invalid endTime %q", endTime)
+	}
+	return req.validate()
+}
 
-		if kline.QuoteAssetVolume, err = strconv.ParseFloat(v[7].(string), 64); err != nil {
-			return nil, err
-		}
+// parseTimeParam parses time in milliseconds, returning zero time for empty s
+func parseTimeParam(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || ms < 0 {
+		return time.Time{}, errors.New("binance: invalid time")
+	}
+	return msTime(ms), nil
+}
 
-		c, ok := v[8].(float64)
-		if !ok {
-			return nil, errors.New("Invalid trades count")
-		}
-		kline.TradesCount = int(c)
+// GetKlines gets lline/candlestick bars for a symbol.
+func (c *Client) GetKlines(symbol, interval, limit, startTime, endTime string) ([]Kline, error) {
+	return c.GetKlinesContext(context.Background(), symbol, interval, limit, startTime, endTime)
+}
 
-		if kline.TakerBuyBaseAssetVol, err = strconv.ParseFloat(v[9].(string), 64); err != nil {
-			return nil, err
-		}
-		if kline.TakerBuyQuoteAssetVol, err = strconv.ParseFloat(v[10].(string), 64); err != nil {
-			return nil, err
-		}
-		list[i] = kline
-	}
+// GetKlinesContext is like GetKlines but uses ctx for the request
+func (c *Client) GetKlinesContext(ctx context.Context, symbol, interval, limit, startTime, endTime string) ([]Kline, error) {
+	p := params{"symbol": symbol, "interval": interval, "limit": limit, "startTime": startTime, "endTime": endTime}
+	return c.fetchKlines(ctx, p)
+}
 
-	return list, err
+func (c *Client) fetchKlines(ctx context.Context, p params) (list []Kline, err error) {
+	err = c.fetch(ctx, addrKlineCandlestickData, p, &list)
+	return
 }
 
 // GetTicker returns 24hr statistics for symbol
-func GetTicker(symbol string) (t *Ticker, err error) {
+func (c *Client) GetTicker(symbol string) (t *Ticker, err error) {
+	return c.GetTickerContext(context.Background(), symbol)
+}
+
+// GetTickerContext is like GetTicker but uses ctx for the request
+func (c *Client) GetTickerContext(ctx context.Context, symbol string) (t *Ticker, err error) {
 	if symbol == "" {
 		return nil, errors.New("Empty symbol")
 	}
 	p := params{"symbol": symbol}
-	err = fetch(addrExchangeData24H, p, &t)
+	err = c.fetch(ctx, addrExchangeData24H, p, &t)
 	return
 }
 
 // GetTickers gets tickers for all symbols
-func GetTickers() (list []Ticker, err error) {
-	err = fetch(addrExchangeData24H, nil, &list)
+func (c *Client) GetTickers() (list []Ticker, err error) {
+	return c.GetTickersContext(context.Background())
+}
+
+// GetTickersContext is like GetTickers but uses ctx for the request
+func (c *Client) GetTickersContext(ctx context.Context) (list []Ticker, err error) {
+	err = c.fetch(ctx, addrExchangeData24H, nil, &list)
 	return
 }
 
 // GetPrice gets latest price for a symbol
-func GetPrice(symbol string) (price *Price, err error) {
+func (c *Client) GetPrice(symbol string) (price *Price, err error) {
+	return c.GetPriceContext(context.Background(), symbol)
+}
+
+// GetPriceContext is like GetPrice but uses ctx for the request
+func (c *Client) GetPriceContext(ctx context.Context, symbol string) (price *Price, err error) {
 	p := params{"symbol": symbol}
-	err = fetch(addrSymbolPriceTicker, p, &price)
+	err = c.fetch(ctx, addrSymbolPriceTicker, p, &price)
 	return
 }
 
 // GetPrices gets latest price for all symbols
-func GetPrices() (list []Price, err error) {
-	err = fetch(addrSymbolPriceTicker, nil, &list)
+func (c *Client) GetPrices() (list []Price, err error) {
+	return c.GetPricesContext(context.Background())
+}
+
+// GetPricesContext is like GetPrices but uses ctx for the request
+func (c *Client) GetPricesContext(ctx context.Context) (list []Price, err error) {
+	err = c.fetch(ctx, addrSymbolPriceTicker, nil, &list)
 	return
 }
 
 // GetOrderBookTicker gets best price/qty on the order book for a symbol
-func GetOrderBookTicker(symbol string) (t *OrderBookTicker, err error) {
+func (c *Client) GetOrderBookTicker(symbol string) (t *OrderBookTicker, err error) {
+	return c.GetOrderBookTickerContext(context.Background(), symbol)
+}
+
+// GetOrderBookTickerContext is like GetOrderBookTicker but uses ctx for the request
+func (c *Client) GetOrderBookTickerContext(ctx context.Context, symbol string) (t *OrderBookTicker, err error) {
 	p := params{"symbol": symbol}
-	err = fetch(addrBookTicker, p, &t)
+	err = c.fetch(ctx, addrBookTicker, p, &t)
 	return
 }
 
 // GetOrderBookTickers gets best price/qty on the order book for all symbols
-func GetOrderBookTickers() (list []OrderBookTicker, err error) {
-	err = fetch(addrBookTicker, nil, &list)
+func (c *Client) GetOrderBookTickers() (list []OrderBookTicker, err error) {
+	return c.GetOrderBookTickersContext(context.Background())
+}
+
+// GetOrderBookTickersContext is like GetOrderBookTickers but uses ctx for the request
+func (c *Client) GetOrderBookTickersContext(ctx context.Context) (list []OrderBookTicker, err error) {
+	err = c.fetch(ctx, addrBookTicker, nil, &list)
 	return
 }
 
 // OpenAggregateTradeStream opens websocket with trade information that is aggregated for a single taker order.
-func OpenAggregateTradeStream(symbol string) (*AggregateTradeStream, error) {
-	ws, err := connectWebsocket(strings.ToLower(symbol) + "@aggTrade")
+func (c *Client) OpenAggregateTradeStream(symbol string) (*AggregateTradeStream, error) {
+	return c.OpenAggregateTradeStreamContext(context.Background(), symbol)
+}
+
+// OpenAggregateTradeStreamContext is like OpenAggregateTradeStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func (c *Client) OpenAggregateTradeStreamContext(ctx context.Context, symbol string) (*AggregateTradeStream, error) {
+	s, err := c.openStream(ctx, AggregateTradeStreamName(symbol))
 	if err != nil {
 		return nil, err
 	}
-	return &AggregateTradeStream{stream{ws}}, nil
+	return &AggregateTradeStream{s}, nil
 }
 
 // OpenTradeStream opens websocket with raw trade information; each trade has a unique buyer and seller.
-func OpenTradeStream(symbol string) (*TradeStream, error) {
-	ws, err := connectWebsocket(strings.ToLower(symbol) + "@trade")
+func (c *Client) OpenTradeStream(symbol string) (*TradeStream, error) {
+	return c.OpenTradeStreamContext(context.Background(), symbol)
+}
+
+// OpenTradeStreamContext is like OpenTradeStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func (c *Client) OpenTradeStreamContext(ctx context.Context, symbol string) (*TradeStream, error) {
+	s, err := c.openStream(ctx, TradeStreamName(symbol))
 	if err != nil {
 		return nil, err
 	}
-	return &TradeStream{stream{ws}}, nil
+	return &TradeStream{s}, nil
 }
 
 // OpenChartStream pushes trade information that is aggregated for a single taker order.
-func OpenChartStream(symbol string, interval ChartInterval) (*ChartStream, error) {
-	ws, err := connectWebsocket(fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval))
+func (c *Client) OpenChartStream(symbol string, interval ChartInterval) (*ChartStream, error) {
+	return c.OpenChartStreamContext(context.Background(), symbol, interval)
+}
+
+// OpenChartStreamContext is like OpenChartStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func (c *Client) OpenChartStreamContext(ctx context.Context, symbol string, interval ChartInterval) (*ChartStream, error) {
+	s, err := c.openStream(ctx, ChartStreamName(symbol, interval))
 	if err != nil {
 		return nil, err
 	}
-	return &ChartStream{stream{ws}}, nil
+	return &ChartStream{s}, nil
 }
 
 // OpenTickerStream pushes trade information that is aggregated for a single taker order.
-func OpenTickerStream(symbol string) (*TickerStream, error) {
-	ws, err := connectWebsocket(strings.ToLower(symbol) + "@ticker")
+func (c *Client) OpenTickerStream(symbol string) (*TickerStream, error) {
+	return c.OpenTickerStreamContext(context.Background(), symbol)
+}
+
+// OpenTickerStreamContext is like OpenTickerStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func (c *Client) OpenTickerStreamContext(ctx context.Context, symbol string) (*TickerStream, error) {
+	s, err := c.openStream(ctx, TickerStreamName(symbol))
 	if err != nil {
 		return nil, err
 	}
-	return &TickerStream{stream{ws}}, nil
+	return &TickerStream{s}, nil
 }
 
 // OpenTickersStream pushes trade information that is aggregated for a single taker order.
-func OpenTickersStream() (*TickersStream, error) {
-	ws, err := connectWebsocket("!ticker@arr")
+func (c *Client) OpenTickersStream() (*TickersStream, error) {
+	return c.OpenTickersStreamContext(context.Background())
+}
+
+// OpenTickersStreamContext is like OpenTickersStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func (c *Client) OpenTickersStreamContext(ctx context.Context) (*TickersStream, error) {
+	s, err := c.openStream(ctx, AllTickersStreamName)
 	if err != nil {
 		return nil, err
 	}
-	return &TickersStream{stream{ws}}, nil
+	return &TickersStream{s}, nil
 }
 
 // OpenPartialBookStream pushes trade information that is aggregated for a single taker order.
-func OpenPartialBookStream(symbol, level string) (*PartialBookStream, error) {
-	ws, err := connectWebsocket(fmt.Sprintf("%s@depth%s", strings.ToLower(symbol), level))
+func (c *Client) OpenPartialBookStream(symbol, level string) (*PartialBookStream, error) {
+	return c.OpenPartialBookStreamContext(context.Background(), symbol, level)
+}
+
+// OpenPartialBookStreamContext is like OpenPartialBookStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func (c *Client) OpenPartialBookStreamContext(ctx context.Context, symbol, level string) (*PartialBookStream, error) {
+	s, err := c.openStream(ctx, PartialBookStreamName(symbol, level))
 	if err != nil {
 		return nil, err
 	}
-	return &PartialBookStream{stream{ws}}, nil
+	return &PartialBookStream{s}, nil
 }
 
 // OpenDiffDepthStream pushes trade information that is aggregated for a single taker order.
-func OpenDiffDepthStream(symbol string) (*DiffDepthStream, error) {
-	ws, err := connectWebsocket(strings.ToLower(symbol) + "@depth")
+func (c *Client) OpenDiffDepthStream(symbol string) (*DiffDepthStream, error) {
+	return c.OpenDiffDepthStreamContext(context.Background(), symbol)
+}
+
+// OpenDiffDepthStreamContext is like OpenDiffDepthStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func (c *Client) OpenDiffDepthStreamContext(ctx context.Context, symbol string) (*DiffDepthStream, error) {
+	s, err := c.openStream(ctx, DiffDepthStreamName(symbol))
 	if err != nil {
 		return nil, err
 	}
-	return &DiffDepthStream{stream{ws}}, nil
+	return &DiffDepthStream{s}, nil
 }
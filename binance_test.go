@@ -0,0 +1,103 @@
+package binance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Response from Binance API documentation of exchange information
+const exchangeInfoResponse = `{
+	"timezone": "UTC",
+	"serverTime": 1565246363776,
+	"rateLimits": [
+		{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 6000},
+		{"rateLimitType": "ORDERS", "interval": "SECOND", "intervalNum": 10, "limit": 100},
+		{"rateLimitType": "ORDERS", "interval": "DAY", "intervalNum": 1, "limit": 200000},
+		{"rateLimitType": "RAW_REQUESTS", "interval": "MINUTE", "intervalNum": 5, "limit": 61000}
+	],
+	"exchangeFilters": [],
+	"symbols": [
+		{
+			"symbol": "ETHBTC",
+			"status": "TRADING",
+			"baseAsset": "ETH",
+			"baseAssetPrecision": 8,
+			"quoteAsset": "BTC",
+			"quotePrecision": 8,
+			"orderTypes": ["LIMIT", "LIMIT_MAKER", "MARKET", "STOP_LOSS_LIMIT", "TAKE_PROFIT_LIMIT"],
+			"icebergAllowed": true,
+			"filters": [
+				{"filterType": "PRICE_FILTER", "minPrice": "0.00000100", "maxPrice": "100000.00000000", "tickSize": "0.00000100"},
+				{"filterType": "LOT_SIZE", "minQty": "0.00100000", "maxQty": "100000.00000000", "stepSize": "0.00100000"},
+				{"filterType": "MIN_NOTIONAL", "minNotional": "0.00100000", "applyToMarket": true, "avgPriceMins": 5},
+				{"filterType": "MAX_NUM_ORDERS", "maxNumOrders": 200}
+			]
+		}
+	]
+}`
+
+func TestGetExchangeInfo(t *testing.T) {
+	weights := []string{"20", "4800"}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-MBX-USED-WEIGHT-1M", weights[0])
+		weights = weights[1:]
+		w.Write([]byte(exchangeInfoResponse))
+	}))
+	defer srv.Close()
+	c := NewClient(WithBaseURL(srv.URL))
+	var crossed []RateLimitUsage
+	c.OnRateLimitThreshold(0.8, func(u RateLimitUsage) { crossed = append(crossed, u) })
+
+	info, err := c.GetExchangeInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Timezone != "UTC" || info.ServerTime != 1565246363776 || len(info.RateLimits) != 4 {
+		t.Errorf("got timezone %q, server time %d, %d rate limits", info.Timezone, info.ServerTime, len(info.RateLimits))
+	}
+	if len(info.Symbols) != 1 {
+		t.Fatalf("got %d symbols, want 1", len(info.Symbols))
+	}
+	s := info.Symbols[0]
+	if s.Name != "ETHBTC" || s.BaseAsset != "ETH" || s.QuoteAsset != "BTC" || s.BaseAssetPrecision != 8 ||
+		!s.IcebergAllowed || len(s.OrderTypes) != 5 || s.OrderTypes[4] != TakeProfitLimitOrder {
+		t.Errorf("got symbol %+v", s)
+	}
+	filters := []SymbolFilter{
+		{FilterType: SymbolFilterTypePrice, MinPrice: 0.000001, MaxPrice: 100000, TickSize: 0.000001},
+		{FilterType: SymbolFilterTypeLotSize, MinQty: 0.001, MaxQty: 100000, StepSize: 0.001},
+		{FilterType: SymbolFilterMinNotional, MinNotional: 0.001},
+		{FilterType: SymbolFilterMaxNumOrders},
+	}
+	if !reflect.DeepEqual(s.Filters, filters) {
+		t.Errorf("got filters %+v, want %+v", s.Filters, filters)
+	}
+
+	want := RateLimitUsage{RateLimitType: RequestWeightRLType, Interval: MinuteRLInterval, IntervalNum: 1, Count: 20, Limit: 6000}
+	usage := c.RateLimitUsage()
+	if len(usage) != 1 {
+		t.Fatalf("got usage %+v, want one limit", usage)
+	}
+	usage[0].UpdateTime = time.Time{}
+	if usage[0] != want {
+		t.Errorf("got usage %+v, want %+v", usage[0], want)
+	}
+
+	if _, err := c.GetExchangeInfo(); err != nil {
+		t.Fatal(err)
+	}
+	if len(crossed) != 1 || crossed[0].Count != 4800 || crossed[0].Ratio() != 0.8 {
+		t.Errorf("got threshold calls %+v, want one at 0.8", crossed)
+	}
+}
+
+func TestGetExchangeInfoEmptyResponse(t *testing.T) {
+	srv, _ := recordingServer("null")
+	defer srv.Close()
+	if info, err := NewClient(WithBaseURL(srv.URL)).GetExchangeInfo(); info != nil || err == nil {
+		t.Errorf("got %+v, %v; want error", info, err)
+	}
+}
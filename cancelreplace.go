@@ -0,0 +1,94 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+type rawCancelReplaceResult struct {
+	CancelResult     CancelReplaceStatus `json:"cancelResult"`
+	NewOrderResult   CancelReplaceStatus `json:"newOrderResult"`
+	CancelResponse   json.RawMessage     `json:"cancelResponse"`
+	NewOrderResponse json.RawMessage     `json:"newOrderResponse"`
+}
+
+// decodeOperation decodes operation response into v, or into *APIError when the operation failed
+func decodeOperation(data json.RawMessage, status CancelReplaceStatus, v interface{}) (*APIError, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	if status == CancelReplaceFailure {
+		apiErr := new(APIError)
+		return apiErr, json.Unmarshal(data, apiErr)
+	}
+	return nil, json.Unmarshal(data, v)
+}
+
+func parseCancelReplaceResult(raw *rawCancelReplaceResult) (*CancelReplaceResult, error) {
+	res := &CancelReplaceResult{
+		CancelResult:   raw.CancelResult,
+		NewOrderResult: raw.NewOrderResult,
+	}
+	var err error
+	cancel := new(OrderInfo)
+	if res.CancelError, err = decodeOperation(raw.CancelResponse, raw.CancelResult, cancel); err != nil {
+		return nil, err
+	}
+	if res.CancelResult == CancelReplaceSuccess {
+		res.CancelResponse = cancel
+	}
+	order := new(OrderResponse)
+	if res.NewOrderError, err = decodeOperation(raw.NewOrderResponse, raw.NewOrderResult, order); err != nil {
+		return nil, err
+	}
+	if res.NewOrderResult == CancelReplaceSuccess {
+		res.NewOrderResponse = order
+	}
+	return res, nil
+}
+
+// CancelReplaceOrder cancels an order and places new order on the same symbol.
+// When any of the operations fails, the result is returned together with
+// *APIError, so callers can tell which of the operations succeeded.
+func (c *Client) CancelReplaceOrder(req *CancelReplaceRequest) (*CancelReplaceResult, error) {
+	return c.CancelReplaceOrderContext(context.Background(), req)
+}
+
+// CancelReplaceOrderContext is like CancelReplaceOrder but uses ctx for the request
+func (c *Client) CancelReplaceOrderContext(ctx context.Context, req *CancelReplaceRequest) (*CancelReplaceResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	if req.Mode == "" {
+		return nil, errors.New("binance: cancel-replace mode is required")
+	}
+	if req.CancelOrderID == 0 && req.CancelOrigClientOrderID == "" {
+		return nil, errors.New("binance: order ID or client order ID of order to cancel is required")
+	}
+	p := req.params()
+	p["cancelReplaceMode"] = string(req.Mode)
+	p["cancelOrderId"] = formatInt(req.CancelOrderID)
+	p["cancelOrigClientOrderId"] = req.CancelOrigClientOrderID
+	p["cancelNewClientOrderId"] = req.CancelNewClientOrderID
+
+	r := &request{method: http.MethodPost, path: addrCancelReplace, params: p, sec: secSigned}
+	raw := new(rawCancelReplaceResult)
+	err := c.do(ctx, r, raw)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && len(apiErr.data) > 0 &&
+		(apiErr.Code == CodeCancelReplacePartiallyFailed || apiErr.Code == CodeCancelReplaceFailed) {
+		if jsonErr := json.Unmarshal(apiErr.data, raw); jsonErr != nil {
+			return nil, jsonErr
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	res, parseErr := parseCancelReplaceResult(raw)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	return res, err
+}
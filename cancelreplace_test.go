@@ -0,0 +1,139 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Responses from Binance API documentation of cancel-replace order
+const (
+	cancelReplaceSuccess = `{
+		"cancelResult": "SUCCESS",
+		"newOrderResult": "SUCCESS",
+		"cancelResponse": {
+			"symbol": "BTCUSDT", "origClientOrderId": "DnLo3vTAQcjha43lAZhZ0y", "orderId": 9,
+			"orderListId": -1, "clientOrderId": "osxN3JXAtJvKvCqGeMWMVR", "price": "0.01000000",
+			"origQty": "0.000100", "executedQty": "0.00000000", "cummulativeQuoteQty": "0.00000000",
+			"status": "CANCELED", "timeInForce": "GTC", "type": "LIMIT", "side": "SELL"
+		},
+		"newOrderResponse": {
+			"symbol": "BTCUSDT", "orderId": 10, "orderListId": -1, "clientOrderId": "wOceeeOzNORyLiQfw7jd8S",
+			"transactTime": 1652928801803, "price": "0.02000000", "origQty": "0.040000",
+			"executedQty": "0.00000000", "cummulativeQuoteQty": "0.00000000", "status": "NEW",
+			"timeInForce": "GTC", "type": "LIMIT", "side": "BUY", "fills": []
+		}
+	}`
+	cancelReplaceCancelFailed = `{
+		"code": -2022,
+		"msg": "Order cancel-replace failed.",
+		"data": {
+			"cancelResult": "FAILURE",
+			"newOrderResult": "NOT_ATTEMPTED",
+			"cancelResponse": {"code": -2011, "msg": "Unknown order sent."},
+			"newOrderResponse": null
+		}
+	}`
+	cancelReplaceNewOrderFailed = `{
+		"code": -2021,
+		"msg": "Order cancel-replace partially failed.",
+		"data": {
+			"cancelResult": "SUCCESS",
+			"newOrderResult": "FAILURE",
+			"cancelResponse": {
+				"symbol": "BTCUSDT", "origClientOrderId": "86M8erehfExV8z2RC8Zo8k", "orderId": 3,
+				"orderListId": -1, "clientOrderId": "G1kLo6aDv2KGNTFcjfTSFq", "price": "0.006123",
+				"origQty": "10000.000000", "executedQty": "0.000000", "cummulativeQuoteQty": "0.000000",
+				"status": "CANCELED", "timeInForce": "GTC", "type": "LIMIT_MAKER", "side": "SELL"
+			},
+			"newOrderResponse": {"code": -2010, "msg": "Order would immediately match and take."}
+		}
+	}`
+)
+
+func TestCancelReplaceOrder(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         int
+		body           string
+		code           int
+		cancelResult   CancelReplaceStatus
+		newOrderResult CancelReplaceStatus
+		cancelID       int64
+		cancelCode     int
+		newOrderID     int64
+		newOrderCode   int
+	}{
+		{
+			name:           "success",
+			status:         http.StatusOK,
+			body:           cancelReplaceSuccess,
+			cancelResult:   CancelReplaceSuccess,
+			newOrderResult: CancelReplaceSuccess,
+			cancelID:       9,
+			newOrderID:     10,
+		},
+		{
+			name:           "cancel failed",
+			status:         http.StatusBadRequest,
+			body:           cancelReplaceCancelFailed,
+			code:           CodeCancelReplaceFailed,
+			cancelResult:   CancelReplaceFailure,
+			newOrderResult: CancelReplaceNotAttempted,
+			cancelCode:     CodeCancelRejected,
+		},
+		{
+			name:           "new order failed",
+			status:         http.StatusBadRequest,
+			body:           cancelReplaceNewOrderFailed,
+			code:           CodeCancelReplacePartiallyFailed,
+			cancelResult:   CancelReplaceSuccess,
+			newOrderResult: CancelReplaceFailure,
+			cancelID:       3,
+			newOrderCode:   CodeNewOrderRejected,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+			c := NewClient(WithBaseURL(srv.URL), WithCredentials("key", "secret"))
+			res, err := c.CancelReplaceOrder(&CancelReplaceRequest{
+				OrderRequest:  OrderRequest{Symbol: "BTCUSDT", Side: BuyOrder, Type: LimitOrder, Quantity: 0.04, Price: 0.02},
+				Mode:          AllowFailure,
+				CancelOrderID: 9,
+			})
+			var apiErr *APIError
+			switch {
+			case tt.code == 0 && err != nil:
+				t.Fatalf("got error %v", err)
+			case tt.code != 0 && (!errors.As(err, &apiErr) || apiErr.Code != tt.code):
+				t.Fatalf("got error %v, want code %d", err, tt.code)
+			case res == nil:
+				t.Fatal("got no result")
+			}
+			if res.CancelResult != tt.cancelResult || res.NewOrderResult != tt.newOrderResult {
+				t.Errorf("got results %s/%s, want %s/%s", res.CancelResult, res.NewOrderResult, tt.cancelResult, tt.newOrderResult)
+			}
+			if got := res.CancelResponse; (got == nil) != (tt.cancelID == 0) || got != nil && got.OrderID != tt.cancelID {
+				t.Errorf("got cancel response %+v, want order %d", got, tt.cancelID)
+			}
+			if got := res.CancelError; (got == nil) != (tt.cancelCode == 0) || got != nil && got.Code != tt.cancelCode {
+				t.Errorf("got cancel error %v, want code %d", got, tt.cancelCode)
+			}
+			if got := res.NewOrderResponse; (got == nil) != (tt.newOrderID == 0) || got != nil && got.OrderID != tt.newOrderID {
+				t.Errorf("got new order response %+v, want order %d", got, tt.newOrderID)
+			}
+			if got := res.NewOrderError; (got == nil) != (tt.newOrderCode == 0) || got != nil && got.Code != tt.newOrderCode {
+				t.Errorf("got new order error %v, want code %d", got, tt.newOrderCode)
+			}
+			if res.CancelSucceeded() != (tt.cancelResult == CancelReplaceSuccess) {
+				t.Errorf("CancelSucceeded() = %v", res.CancelSucceeded())
+			}
+		})
+	}
+}
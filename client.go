@@ -0,0 +1,134 @@
+package binance
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	defaultBaseURL       = "https://api.binance.com"
+	defaultStreamBaseURL = "wss://stream.binance.com:9443"
+)
+
+// Client is a Binance API client. Each client carries its own endpoint
+// addresses and HTTP transport, so several independently configured clients
+// can be used in one process.
+type Client struct {
+	baseURL       string
+	streamBaseURL string
+	apiStreamURL  string
+	httpClient    *http.Client
+	userAgent     string
+	apiKey        string
+	signer        Signer
+	recvWindow    time.Duration
+	usage         *usageTracker
+	limiter       *rateLimiter
+	retry         *retrier
+	clock         *timeSync
+	reconnect     *ReconnectPolicy
+	readTimeout   time.Duration
+}
+
+// ClientOption configures a Client
+type ClientOption func(*Client)
+
+// WithBaseURL sets the REST API base URL, e.g. "https://api.binance.com"
+func WithBaseURL(u string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(u, "/")
+	}
+}
+
+// WithStreamBaseURL sets the websocket streams base URL, e.g. "wss://stream.binance.com:9443"
+func WithStreamBaseURL(u string) ClientOption {
+	return func(c *Client) {
+		c.streamBaseURL = strings.TrimSuffix(u, "/")
+	}
+}
+
+// WithAPIStreamURL sets the websocket API address, e.g. "wss://ws-api.binance.com:443/ws-api/v3"
+func WithAPIStreamURL(u string) ClientOption {
+	return func(c *Client) {
+		c.apiStreamURL = u
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for REST calls
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every REST call
+func WithUserAgent(ua string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithTimeout sets the overall timeout of a single REST call.
+// The HTTP client is copied, so a client passed with WithHTTPClient is not modified.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		hc := *c.httpClient
+		hc.Timeout = d
+		c.httpClient = &hc
+	}
+}
+
+// WithCredentials sets the API key and secret key used for authenticated calls.
+// Requests to SIGNED endpoints are signed with HMAC-SHA256 using the secret key.
+func WithCredentials(apiKey, secretKey string) ClientOption {
+	return WithSigner(apiKey, NewHMACSigner(secretKey))
+}
+
+// WithSigner sets the API key used for authenticated calls and the signer
+// for requests to SIGNED endpoints, e.g. an RSASigner or Ed25519Signer.
+func WithSigner(apiKey string, s Signer) ClientOption {
+	return func(c *Client) {
+		c.apiKey = apiKey
+		c.signer = s
+	}
+}
+
+// WithRecvWindow sets how long after its timestamp a signed request is valid.
+// Binance uses 5 seconds when not set.
+func WithRecvWindow(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.recvWindow = d
+	}
+}
+
+// WithRateLimiter enables client side rate limiting. Before a request is sent its weight
+// is budgeted against REQUEST_WEIGHT, RAW_REQUESTS and ORDERS limits; mode defines whether
+// a request that does not fit waits for the limit window to reset or fails.
+// Limits are loaded by GetExchangeInfo or set by SetRateLimits; until then requests are not limited.
+func WithRateLimiter(mode RateLimitMode) ClientOption {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(mode)
+	}
+}
+
+// NewClient creates a new client. Without options the client talks to
+// the production Binance endpoints using http.DefaultClient.
+func NewClient(opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL:       defaultBaseURL,
+		streamBaseURL: defaultStreamBaseURL,
+		apiStreamURL:  defaultAPIStreamURL,
+		httpClient:    http.DefaultClient,
+		usage:         newUsageTracker(),
+		clock:         new(timeSync),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.usage.limiter = c.limiter
+	return c
+}
+
+// defaultClient backs the package-level functions
+var defaultClient = NewClient()
@@ -0,0 +1,420 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// maxStreamsPerConn is the number of streams Binance allows on a single connection
+const maxStreamsPerConn = 1024
+
+// AllTickersStreamName is the name of the stream with tickers of all symbols
+const AllTickersStreamName = "!ticker@arr"
+
+// AggregateTradeStreamName returns name of aggregate trade stream of symbol
+func AggregateTradeStreamName(symbol string) string {
+	return strings.ToLower(symbol) + "@aggTrade"
+}
+
+// TradeStreamName returns name of trade stream of symbol
+func TradeStreamName(symbol string) string {
+	return strings.ToLower(symbol) + "@trade"
+}
+
+// ChartStreamName returns name of kline/candlestick stream of symbol
+func ChartStreamName(symbol string, interval ChartInterval) string {
+	return fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval)
+}
+
+// TickerStreamName returns name of ticker stream of symbol
+func TickerStreamName(symbol string) string {
+	return strings.ToLower(symbol) + "@ticker"
+}
+
+// PartialBookStreamName returns name of partial book depth stream of symbol with given level, e.g. "5"
+func PartialBookStreamName(symbol, level string) string {
+	return fmt.Sprintf("%s@depth%s", strings.ToLower(symbol), level)
+}
+
+// DiffDepthStreamName returns name of diff. depth stream of symbol
+func DiffDepthStreamName(symbol string) string {
+	return strings.ToLower(symbol) + "@depth"
+}
+
+// StreamEvent is an event read from CombinedStream
+type StreamEvent struct {
+	// Stream is the name of the stream the event was sent to
+	Stream string
+
+	// Data is the event as received from Binance
+	Data json.RawMessage
+
+	// Event is the decoded event: *AggregateTradeEvent, *TradeEvent, *ChartEvent,
+	// *TickerEvent, []TickerEvent, *OrderBook or *DiffDepth depending on the stream.
+	// It is nil for streams of other types.
+	Event interface{}
+}
+
+// streamSymbol returns symbol of stream with given name, e.g. "BNBBTC" of "bnbbtc@depth5"
+func streamSymbol(name string) string {
+	if i := strings.IndexByte(name, '@'); i >= 0 {
+		return strings.ToUpper(name[:i])
+	}
+	return ""
+}
+
+// isDiffDepthStream reports whether name is a diff. depth stream, e.g. "bnbbtc@depth@100ms"
+func isDiffDepthStream(name string) bool {
+	stream := name[strings.IndexByte(name, '@')+1:]
+	return stream == "depth" || strings.HasPrefix(stream, "depth@")
+}
+
+// decodeStreamEvent decodes data of stream with given name
+func decodeStreamEvent(name string, data []byte) (interface{}, error) {
+	var event interface{}
+	stream := name[strings.IndexByte(name, '@')+1:]
+	switch {
+	case name == AllTickersStreamName:
+		event = new([]TickerEvent)
+	case stream == "aggTrade":
+		event = new(AggregateTradeEvent)
+	case stream == "trade":
+		event = new(TradeEvent)
+	case strings.HasPrefix(stream, "kline_"):
+		event = new(ChartEvent)
+	case stream == "ticker":
+		event = new(TickerEvent)
+	case isDiffDepthStream(name):
+		return parseDiffDepth(data)
+	case strings.HasPrefix(stream, "depth"):
+		book, err := parsePartialBook(data)
+		if err != nil {
+			return nil, err
+		}
+		book.Symbol = streamSymbol(name)
+		return book, nil
+	default:
+		return nil, nil
+	}
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+	if list, ok := event.(*[]TickerEvent); ok {
+		return *list, nil
+	}
+	return event, nil
+}
+
+type streamResult struct {
+	event *StreamEvent
+	err   error
+}
+
+// CombinedStream delivers events of many streams. Streams are spread over as many
+// connections as needed to stay within the number of streams Binance allows per connection.
+type CombinedStream struct {
+	client  *Client
+	parent  context.Context
+	ctx     context.Context
+	cancel  context.CancelFunc
+	results chan streamResult
+	wg      sync.WaitGroup
+
+	// subscribeMu serializes changes of subscriptions
+	subscribeMu sync.Mutex
+
+	mu     sync.Mutex
+	conns  []*streamConn
+	active int
+}
+
+// OpenCombinedStream opens combined stream of given stream names,
+// e.g. TradeStreamName("BNBBTC") or ChartStreamName("BNBBTC", ChartIntervalOneMin)
+func (c *Client) OpenCombinedStream(names ...string) (*CombinedStream, error) {
+	return c.OpenCombinedStreamContext(context.Background(), names...)
+}
+
+// OpenCombinedStreamContext is like OpenCombinedStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func (c *Client) OpenCombinedStreamContext(ctx context.Context, names ...string) (*CombinedStream, error) {
+	if len(names) == 0 {
+		return nil, errors.New("binance: at least one stream name is required")
+	}
+	parent := ctx
+	ctx, cancel := context.WithCancel(parent)
+	s := &CombinedStream{
+		client:  c,
+		parent:  parent,
+		ctx:     ctx,
+		cancel:  cancel,
+		results: make(chan streamResult),
+	}
+	go func() {
+		<-ctx.Done()
+		// Connections are not added once ctx is done, see add
+		s.mu.Lock()
+		s.mu.Unlock()
+		s.wg.Wait()
+		close(s.results)
+	}()
+	if err := s.open(names); err != nil {
+		s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// open opens connections for names, up to maxStreamsPerConn streams each
+func (s *CombinedStream) open(names []string) error {
+	for len(names) > 0 {
+		n := len(names)
+		if n > maxStreamsPerConn {
+			n = maxStreamsPerConn
+		}
+		conn, err := s.client.openStreamConn(s.ctx, append([]string(nil), names[:n]...), true)
+		if err != nil {
+			return err
+		}
+		if !s.add(conn) {
+			conn.Close()
+			return contextError(s.parent, ErrStreamClosed)
+		}
+		names = names[n:]
+	}
+	return nil
+}
+
+// add starts reading conn, reporting false when the stream is already closed
+func (s *CombinedStream) add(conn *streamConn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ctx.Err() != nil {
+		return false
+	}
+	s.conns = append(s.conns, conn)
+	s.active++
+	s.wg.Add(1)
+	go s.run(conn)
+	return true
+}
+
+// connections returns current connections of the stream
+func (s *CombinedStream) connections() []*streamConn {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*streamConn(nil), s.conns...)
+}
+
+// run reads conn until it is closed or fails. While the consumer is busy and
+// a subscription request waits for its response, conn is read ahead so that
+// the response is received; events read meanwhile are queued until delivered.
+func (s *CombinedStream) run(conn *streamConn) {
+	defer s.wg.Done()
+	defer func() {
+		// Stream is closed once all of its connections failed
+		s.mu.Lock()
+		s.active--
+		if s.active == 0 {
+			s.cancel()
+		}
+		s.mu.Unlock()
+	}()
+	var queue []streamResult
+	failed := false
+	for {
+		if len(queue) == 0 {
+			if failed {
+				return
+			}
+			queue, failed = receive(conn, queue)
+		}
+		select {
+		case s.results <- queue[0]:
+			queue = queue[1:]
+		case <-conn.calling:
+			for !failed && conn.hasPending() {
+				queue, failed = receive(conn, queue)
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// receive reads next event of conn into queue, reporting whether conn failed
+func receive(conn *streamConn, queue []streamResult) ([]streamResult, bool) {
+	data, err := conn.readMessage()
+	if err != nil {
+		var reconnected *ReconnectedError
+		return append(queue, streamResult{err: err}), !errors.As(err, &reconnected)
+	}
+	event, err := parseStreamEnvelope(data)
+	return append(queue, streamResult{event, err}), false
+}
+
+// parseStreamEnvelope unwraps event from combined stream envelope and decodes it
+func parseStreamEnvelope(data []byte) (*StreamEvent, error) {
+	var envelope struct {
+		Stream string          `json:"stream"`
+		Data   json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	}
+	event, err := decodeStreamEvent(envelope.Stream, envelope.Data)
+	if err != nil {
+		return nil, fmt.Errorf("binance: invalid %s event: %v", envelope.Stream, err)
+	}
+	return &StreamEvent{Stream: envelope.Stream, Data: envelope.Data, Event: event}, nil
+}
+
+// Read returns next event of any of the streams. Reconnect of a connection
+// is reported with *ReconnectedError; reading can continue after it.
+func (s *CombinedStream) Read() (*StreamEvent, error) {
+	res, ok := <-s.results
+	if !ok {
+		return nil, contextError(s.parent, ErrStreamClosed)
+	}
+	return res.event, res.err
+}
+
+// State returns StreamReconnecting while any connection of the stream is reconnecting,
+// StreamClosed once the stream is closed and StreamConnected otherwise
+func (s *CombinedStream) State() StreamState {
+	if s.ctx.Err() != nil {
+		return StreamClosed
+	}
+	for _, conn := range s.connections() {
+		if conn.State() == StreamReconnecting {
+			return StreamReconnecting
+		}
+	}
+	return StreamConnected
+}
+
+// Streams returns names of the streams
+func (s *CombinedStream) Streams() []string {
+	var names []string
+	for _, conn := range s.connections() {
+		conn.mu.Lock()
+		names = append(names, conn.names...)
+		conn.mu.Unlock()
+	}
+	return names
+}
+
+// Close closes all connections of the stream
+func (s *CombinedStream) Close() error {
+	var err error
+	for _, conn := range s.connections() {
+		if e := conn.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	s.cancel()
+	s.wg.Wait()
+	return err
+}
+
+// Subscribe subscribes the stream to more streams. Connections are subscribed
+// while they have room for more streams, then new connections are opened.
+// Subscribe may be called from the goroutine reading the stream or from a handler;
+// events arriving while it waits for the response are kept in memory until they are read.
+func (s *CombinedStream) Subscribe(names ...string) error {
+	return s.SubscribeContext(context.Background(), names...)
+}
+
+// SubscribeContext is like Subscribe but uses ctx for the requests
+func (s *CombinedStream) SubscribeContext(ctx context.Context, names ...string) error {
+	s.subscribeMu.Lock()
+	defer s.subscribeMu.Unlock()
+	if s.ctx.Err() != nil {
+		return contextError(s.parent, ErrStreamClosed)
+	}
+	conns := s.connections()
+	subscribed := make(map[string]bool)
+	for _, conn := range conns {
+		conn.mu.Lock()
+		for _, name := range conn.names {
+			subscribed[name] = true
+		}
+		conn.mu.Unlock()
+	}
+	var rest []string
+	for _, name := range names {
+		if !subscribed[name] {
+			subscribed[name] = true
+			rest = append(rest, name)
+		}
+	}
+	for _, conn := range conns {
+		if len(rest) == 0 {
+			break
+		}
+		conn.mu.Lock()
+		n := maxStreamsPerConn - len(conn.names)
+		closed := conn.state == StreamClosed
+		conn.mu.Unlock()
+		if n <= 0 || closed {
+			continue
+		}
+		if n > len(rest) {
+			n = len(rest)
+		}
+		if err := conn.SubscribeContext(ctx, rest[:n]...); err != nil {
+			return err
+		}
+		rest = rest[n:]
+	}
+	return s.open(rest)
+}
+
+// Unsubscribe unsubscribes the stream from streams
+func (s *CombinedStream) Unsubscribe(names ...string) error {
+	return s.UnsubscribeContext(context.Background(), names...)
+}
+
+// UnsubscribeContext is like Unsubscribe but uses ctx for the requests
+func (s *CombinedStream) UnsubscribeContext(ctx context.Context, names ...string) error {
+	s.subscribeMu.Lock()
+	defer s.subscribeMu.Unlock()
+	for _, conn := range s.connections() {
+		var list []string
+		conn.mu.Lock()
+		for _, name := range names {
+			if indexOf(conn.names, name) >= 0 {
+				list = append(list, name)
+			}
+		}
+		conn.mu.Unlock()
+		if len(list) == 0 {
+			continue
+		}
+		if err := conn.UnsubscribeContext(ctx, list...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListSubscriptions returns streams of all connections as reported by Binance
+func (s *CombinedStream) ListSubscriptions() ([]string, error) {
+	return s.ListSubscriptionsContext(context.Background())
+}
+
+// ListSubscriptionsContext is like ListSubscriptions but uses ctx for the requests
+func (s *CombinedStream) ListSubscriptionsContext(ctx context.Context) ([]string, error) {
+	var names []string
+	for _, conn := range s.connections() {
+		list, err := conn.ListSubscriptionsContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, list...)
+	}
+	return names, nil
+}
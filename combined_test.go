@@ -0,0 +1,112 @@
+package binance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// combinedServer sends a trade event every few milliseconds and answers
+// subscription requests after delay
+func combinedServer(delay time.Duration) *httptest.Server {
+	var upgrader websocket.Upgrader
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		socket, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer socket.Close()
+		var mu sync.Mutex
+		write := func(data string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			return socket.WriteMessage(websocket.TextMessage, []byte(data))
+		}
+		go func() {
+			for {
+				var req controlRequest
+				if err := socket.ReadJSON(&req); err != nil {
+					return
+				}
+				time.AfterFunc(delay, func() {
+					write(`{"result":null,"id":` + strconv.FormatUint(req.ID, 10) + `}`)
+				})
+			}
+		}()
+		for i := 0; ; i++ {
+			err := write(`{"stream":"bnbbtc@trade","data":{"e":"trade","E":1,"s":"BNBBTC","t":` +
+				strconv.Itoa(i) + `,"p":"1","q":"1","T":1,"m":true,"M":true}}`)
+			if err != nil {
+				return
+			}
+			time.Sleep(2 * time.Millisecond)
+		}
+	}))
+}
+
+func openTestCombinedStream(t *testing.T, srv *httptest.Server) *CombinedStream {
+	c := NewClient(WithStreamBaseURL("ws" + strings.TrimPrefix(srv.URL, "http")))
+	s, err := c.OpenCombinedStream(TradeStreamName("BNBBTC"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func waitSubscribed(t *testing.T, errc <-chan error) {
+	select {
+	case err := <-errc:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscribe did not return")
+	}
+}
+
+func TestCombinedStreamSubscribeFromReader(t *testing.T) {
+	srv := combinedServer(20 * time.Millisecond)
+	defer srv.Close()
+	s := openTestCombinedStream(t, srv)
+	defer s.Close()
+	if _, err := s.Read(); err != nil {
+		t.Fatal(err)
+	}
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.Subscribe(TradeStreamName("ETHBTC"))
+	}()
+	waitSubscribed(t, errc)
+	for i := 0; i < 20; i++ {
+		if _, err := s.Read(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if names := s.Streams(); len(names) != 2 {
+		t.Errorf("got streams %v, want 2", names)
+	}
+}
+
+func TestCombinedStreamSubscribeFromHandler(t *testing.T) {
+	srv := combinedServer(10 * time.Millisecond)
+	defer srv.Close()
+	s := openTestCombinedStream(t, srv)
+	errc := make(chan error, 1)
+	var once sync.Once
+	d := s.Handle(DeliveryOptions{Buffer: 1}, func(*StreamEvent) {
+		once.Do(func() {
+			// Let the buffer fill up so that the stream is not read
+			time.Sleep(20 * time.Millisecond)
+			errc <- s.Subscribe(TradeStreamName("ETHBTC"))
+		})
+	})
+	waitSubscribed(t, errc)
+	s.Close()
+	<-d.Done()
+}
@@ -0,0 +1,292 @@
+package binance
+
+import (
+	"context"
+	"time"
+)
+
+// Ping tests connection to the Rest API
+func Ping() error {
+	return defaultClient.Ping()
+}
+
+// PingContext is like Ping but uses ctx for the request
+func PingContext(ctx context.Context) error {
+	return defaultClient.PingContext(ctx)
+}
+
+// GetServerTime gets Binance server time
+func GetServerTime() (t *time.Time, err error) {
+	return defaultClient.GetServerTime()
+}
+
+// GetServerTimeContext is like GetServerTime but uses ctx for the request
+func GetServerTimeContext(ctx context.Context) (t *time.Time, err error) {
+	return defaultClient.GetServerTimeContext(ctx)
+}
+
+// GetExchangeInfo gets trade information for all symbols
+func GetExchangeInfo() (info *ExchangeInfo, err error) {
+	return defaultClient.GetExchangeInfo()
+}
+
+// GetExchangeInfoContext is like GetExchangeInfo but uses ctx for the request
+func GetExchangeInfoContext(ctx context.Context) (info *ExchangeInfo, err error) {
+	return defaultClient.GetExchangeInfoContext(ctx)
+}
+
+// GetOrderBook gets orders for given symbol.
+// Weight is adjusted based on the limit where
+// [Limit 1-100] = [Weight 5];
+// [Limit 101-500] = [Weight 25];
+// [Limit 501-1000] = [Weight 50];
+// [Limit 1001-5000] = [Weight 250]
+func GetOrderBook(symbol, limit string) (*OrderBook, error) {
+	return defaultClient.GetOrderBook(symbol, limit)
+}
+
+// GetOrderBookContext is like GetOrderBook but uses ctx for the request
+func GetOrderBookContext(ctx context.Context, symbol, limit string) (*OrderBook, error) {
+	return defaultClient.GetOrderBookContext(ctx, symbol, limit)
+}
+
+// GetRecentTrades gets up to 500 trades
+func GetRecentTrades(symbol, limit string) (list []Trade, err error) {
+	return defaultClient.GetRecentTrades(symbol, limit)
+}
+
+// GetRecentTradesContext is like GetRecentTrades but uses ctx for the request
+func GetRecentTradesContext(ctx context.Context, symbol, limit string) (list []Trade, err error) {
+	return defaultClient.GetRecentTradesContext(ctx, symbol, limit)
+}
+
+// GetOldTrades gets up to 500 older trades
+func GetOldTrades(symbol, limit, fromID string) (list []Trade, err error) {
+	return defaultClient.GetOldTrades(symbol, limit, fromID)
+}
+
+// GetOldTradesContext is like GetOldTrades but uses ctx for the request
+func GetOldTradesContext(ctx context.Context, symbol, limit, fromID string) (list []Trade, err error) {
+	return defaultClient.GetOldTradesContext(ctx, symbol, limit, fromID)
+}
+
+// GetAggregateTrades get compressed, aggregate trades.
+// Trades that fill at the time, from the same order,
+// with the same price will have the quantity aggregated.
+func GetAggregateTrades(symbol, limit, fromID, startTime, endTime string) (list []AggregateTrade, err error) {
+	return defaultClient.GetAggregateTrades(symbol, limit, fromID, startTime, endTime)
+}
+
+// GetAggregateTradesContext is like GetAggregateTrades but uses ctx for the request
+func GetAggregateTradesContext(ctx context.Context, symbol, limit, fromID, startTime, endTime string) (list []AggregateTrade, err error) {
+	return defaultClient.GetAggregateTradesContext(ctx, symbol, limit, fromID, startTime, endTime)
+}
+
+// GetKlines gets lline/candlestick bars for a symbol.
+func GetKlines(symbol, interval, limit, startTime, endTime string) ([]Kline, error) {
+	return defaultClient.GetKlines(symbol, interval, limit, startTime, endTime)
+}
+
+// GetKlinesContext is like GetKlines but uses ctx for the request
+func GetKlinesContext(ctx context.Context, symbol, interval, limit, startTime, endTime string) ([]Kline, error) {
+	return defaultClient.GetKlinesContext(ctx, symbol, interval, limit, startTime, endTime)
+}
+
+// QueryOrderBook is like GetOrderBook but takes typed parameters
+func QueryOrderBook(req *OrderBookRequest) (*OrderBook, error) {
+	return defaultClient.QueryOrderBook(req)
+}
+
+// QueryOrderBookContext is like QueryOrderBook but uses ctx for the request
+func QueryOrderBookContext(ctx context.Context, req *OrderBookRequest) (*OrderBook, error) {
+	return defaultClient.QueryOrderBookContext(ctx, req)
+}
+
+// QueryTrades is like GetRecentTrades but takes typed parameters
+func QueryTrades(req *TradesRequest) ([]Trade, error) {
+	return defaultClient.QueryTrades(req)
+}
+
+// QueryTradesContext is like QueryTrades but uses ctx for the request
+func QueryTradesContext(ctx context.Context, req *TradesRequest) ([]Trade, error) {
+	return defaultClient.QueryTradesContext(ctx, req)
+}
+
+// QueryHistoricalTrades is like GetOldTrades but takes typed parameters
+func QueryHistoricalTrades(req *HistoricalTradesRequest) ([]Trade, error) {
+	return defaultClient.QueryHistoricalTrades(req)
+}
+
+// QueryHistoricalTradesContext is like QueryHistoricalTrades but uses ctx for the request
+func QueryHistoricalTradesContext(ctx context.Context, req *HistoricalTradesRequest) ([]Trade, error) {
+	return defaultClient.QueryHistoricalTradesContext(ctx, req)
+}
+
+// QueryAggregateTrades is like GetAggregateTrades but takes typed parameters
+func QueryAggregateTrades(req *AggregateTradesRequest) ([]AggregateTrade, error) {
+	return defaultClient.QueryAggregateTrades(req)
+}
+
+// QueryAggregateTradesContext is like QueryAggregateTrades but uses ctx for the request
+func QueryAggregateTradesContext(ctx context.Context, req *AggregateTradesRequest) ([]AggregateTrade, error) {
+	return defaultClient.QueryAggregateTradesContext(ctx, req)
+}
+
+// QueryKlines is like GetKlines but takes typed parameters
+func QueryKlines(req *KlinesRequest) ([]Kline, error) {
+	return defaultClient.QueryKlines(req)
+}
+
+// QueryKlinesContext is like QueryKlines but uses ctx for the request
+func QueryKlinesContext(ctx context.Context, req *KlinesRequest) ([]Kline, error) {
+	return defaultClient.QueryKlinesContext(ctx, req)
+}
+
+// GetTicker returns 24hr statistics for symbol
+func GetTicker(symbol string) (t *Ticker, err error) {
+	return defaultClient.GetTicker(symbol)
+}
+
+// GetTickerContext is like GetTicker but uses ctx for the request
+func GetTickerContext(ctx context.Context, symbol string) (t *Ticker, err error) {
+	return defaultClient.GetTickerContext(ctx, symbol)
+}
+
+// GetTickers gets tickers for all symbols
+func GetTickers() (list []Ticker, err error) {
+	return defaultClient.GetTickers()
+}
+
+// GetTickersContext is like GetTickers but uses ctx for the request
+func GetTickersContext(ctx context.Context) (list []Ticker, err error) {
+	return defaultClient.GetTickersContext(ctx)
+}
+
+// GetPrice gets latest price for a symbol
+func GetPrice(symbol string) (price *Price, err error) {
+	return defaultClient.GetPrice(symbol)
+}
+
+// GetPriceContext is like GetPrice but uses ctx for the request
+func GetPriceContext(ctx context.Context, symbol string) (price *Price, err error) {
+	return defaultClient.GetPriceContext(ctx, symbol)
+}
+
+// GetPrices gets latest price for all symbols
+func GetPrices() (list []Price, err error) {
+	return defaultClient.GetPrices()
+}
+
+// GetPricesContext is like GetPrices but uses ctx for the request
+func GetPricesContext(ctx context.Context) (list []Price, err error) {
+	return defaultClient.GetPricesContext(ctx)
+}
+
+// GetOrderBookTicker gets best price/qty on the order book for a symbol
+func GetOrderBookTicker(symbol string) (t *OrderBookTicker, err error) {
+	return defaultClient.GetOrderBookTicker(symbol)
+}
+
+// GetOrderBookTickerContext is like GetOrderBookTicker but uses ctx for the request
+func GetOrderBookTickerContext(ctx context.Context, symbol string) (t *OrderBookTicker, err error) {
+	return defaultClient.GetOrderBookTickerContext(ctx, symbol)
+}
+
+// GetOrderBookTickers gets best price/qty on the order book for all symbols
+func GetOrderBookTickers() (list []OrderBookTicker, err error) {
+	return defaultClient.GetOrderBookTickers()
+}
+
+// GetOrderBookTickersContext is like GetOrderBookTickers but uses ctx for the request
+func GetOrderBookTickersContext(ctx context.Context) (list []OrderBookTicker, err error) {
+	return defaultClient.GetOrderBookTickersContext(ctx)
+}
+
+// OpenAggregateTradeStream opens websocket with trade information that is aggregated for a single taker order.
+func OpenAggregateTradeStream(symbol string) (*AggregateTradeStream, error) {
+	return defaultClient.OpenAggregateTradeStream(symbol)
+}
+
+// OpenAggregateTradeStreamContext is like OpenAggregateTradeStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func OpenAggregateTradeStreamContext(ctx context.Context, symbol string) (*AggregateTradeStream, error) {
+	return defaultClient.OpenAggregateTradeStreamContext(ctx, symbol)
+}
+
+// OpenTradeStream opens websocket with raw trade information; each trade has a unique buyer and seller.
+func OpenTradeStream(symbol string) (*TradeStream, error) {
+	return defaultClient.OpenTradeStream(symbol)
+}
+
+// OpenTradeStreamContext is like OpenTradeStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func OpenTradeStreamContext(ctx context.Context, symbol string) (*TradeStream, error) {
+	return defaultClient.OpenTradeStreamContext(ctx, symbol)
+}
+
+// OpenChartStream pushes trade information that is aggregated for a single taker order.
+func OpenChartStream(symbol string, interval ChartInterval) (*ChartStream, error) {
+	return defaultClient.OpenChartStream(symbol, interval)
+}
+
+// OpenChartStreamContext is like OpenChartStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func OpenChartStreamContext(ctx context.Context, symbol string, interval ChartInterval) (*ChartStream, error) {
+	return defaultClient.OpenChartStreamContext(ctx, symbol, interval)
+}
+
+// OpenTickerStream pushes trade information that is aggregated for a single taker order.
+func OpenTickerStream(symbol string) (*TickerStream, error) {
+	return defaultClient.OpenTickerStream(symbol)
+}
+
+// OpenTickerStreamContext is like OpenTickerStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func OpenTickerStreamContext(ctx context.Context, symbol string) (*TickerStream, error) {
+	return defaultClient.OpenTickerStreamContext(ctx, symbol)
+}
+
+// OpenTickersStream pushes trade information that is aggregated for a single taker order.
+func OpenTickersStream() (*TickersStream, error) {
+	return defaultClient.OpenTickersStream()
+}
+
+// OpenTickersStreamContext is like OpenTickersStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func OpenTickersStreamContext(ctx context.Context) (*TickersStream, error) {
+	return defaultClient.OpenTickersStreamContext(ctx)
+}
+
+// OpenPartialBookStream pushes trade information that is aggregated for a single taker order.
+func OpenPartialBookStream(symbol, level string) (*PartialBookStream, error) {
+	return defaultClient.OpenPartialBookStream(symbol, level)
+}
+
+// OpenPartialBookStreamContext is like OpenPartialBookStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func OpenPartialBookStreamContext(ctx context.Context, symbol, level string) (*PartialBookStream, error) {
+	return defaultClient.OpenPartialBookStreamContext(ctx, symbol, level)
+}
+
+// OpenDiffDepthStream pushes trade information that is aggregated for a single taker order.
+func OpenDiffDepthStream(symbol string) (*DiffDepthStream, error) {
+	return defaultClient.OpenDiffDepthStream(symbol)
+}
+
+// OpenDiffDepthStreamContext is like OpenDiffDepthStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func OpenDiffDepthStreamContext(ctx context.Context, symbol string) (*DiffDepthStream, error) {
+	return defaultClient.OpenDiffDepthStreamContext(ctx, symbol)
+}
+
+// OpenCombinedStream opens combined stream of given stream names,
+// e.g. TradeStreamName("BNBBTC") or ChartStreamName("BNBBTC", ChartIntervalOneMin)
+func OpenCombinedStream(names ...string) (*CombinedStream, error) {
+	return defaultClient.OpenCombinedStream(names...)
+}
+
+// OpenCombinedStreamContext is like OpenCombinedStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func OpenCombinedStreamContext(ctx context.Context, names ...string) (*CombinedStream, error) {
+	return defaultClient.OpenCombinedStreamContext(ctx, names...)
+}
@@ -0,0 +1,375 @@
+package binance
+
+import (
+	"sync"
+)
+
+// defaultDeliveryBuffer is the number of queued events when DeliveryOptions.Buffer is not set
+const defaultDeliveryBuffer = 100
+
+// BackpressurePolicy defines what happens with events of a stream when its consumer
+// is slower than the stream and the delivery buffer is full
+type BackpressurePolicy int
+
+const (
+	// BackpressureBlock stops reading the stream until the consumer catches up
+	BackpressureBlock BackpressurePolicy = iota
+
+	// BackpressureDropOldest drops the oldest queued event to make room for a new one
+	BackpressureDropOldest
+
+	// BackpressureDropNewest drops new events until there is room for them
+	BackpressureDropNewest
+
+	// BackpressureCoalesce replaces queued event of the same symbol with a newer one,
+	// so the consumer gets only the latest event of every symbol. Events of combined
+	// streams are coalesced per stream; diff. depth and user data events are never coalesced. When the
+	// buffer is full of events that cannot be coalesced, reading the stream stops until
+	// the consumer catches up.
+	BackpressureCoalesce
+)
+
+// DeliveryOptions configures delivery of stream events to a channel or handler
+type DeliveryOptions struct {
+	// Buffer is the number of events queued for a slow consumer, 100 when not set
+	Buffer int
+
+	// Policy defines what happens when the buffer is full
+	Policy BackpressurePolicy
+
+	// OnError is called with errors the stream recovers from, e.g. *ReconnectedError
+	// or an event that could not be decoded
+	OnError func(error)
+}
+
+// Delivery reads a stream in a managed goroutine and delivers its events to the consumer.
+// Delivery ends when the stream is closed or fails.
+type Delivery struct {
+	queue *eventQueue
+	done  chan struct{}
+	err   error
+}
+
+// Dropped returns number of events dropped or replaced by a newer event because the consumer was slow
+func (d *Delivery) Dropped() uint64 {
+	d.queue.mu.Lock()
+	defer d.queue.mu.Unlock()
+	return d.queue.dropped
+}
+
+// Done returns a channel closed when all events were delivered after the stream ended
+func (d *Delivery) Done() <-chan struct{} {
+	return d.done
+}
+
+// Err returns the error that ended the stream. It returns nil until Done is closed.
+func (d *Delivery) Err() error {
+	select {
+	case <-d.done:
+		return d.err
+	default:
+		return nil
+	}
+}
+
+type queuedEvent struct {
+	key   string
+	event interface{}
+}
+
+// eventQueue queues events between the goroutine reading a stream and its consumer
+type eventQueue struct {
+	size   int
+	policy BackpressurePolicy
+
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	events   []queuedEvent
+	closed   bool
+	dropped  uint64
+}
+
+func newEventQueue(opts DeliveryOptions) *eventQueue {
+	q := &eventQueue{size: opts.Buffer, policy: opts.Policy}
+	if q.size <= 0 {
+		q.size = defaultDeliveryBuffer
+	}
+	q.notEmpty = sync.NewCond(&q.mu)
+	q.notFull = sync.NewCond(&q.mu)
+	return q
+}
+
+func (q *eventQueue) push(key string, event interface{}) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.policy == BackpressureCoalesce && key != "" {
+		for i := range q.events {
+			if q.events[i].key == key {
+				q.events[i].event = event
+				q.dropped++
+				return
+			}
+		}
+	}
+	for len(q.events) >= q.size && !q.closed {
+		switch q.policy {
+		case BackpressureDropNewest:
+			q.dropped++
+			return
+		case BackpressureDropOldest:
+			q.events = q.events[1:]
+			q.dropped++
+		default:
+			q.notFull.Wait()
+		}
+	}
+	q.events = append(q.events, queuedEvent{key, event})
+	q.notEmpty.Signal()
+}
+
+// pop returns next event, reporting false when the queue is closed and empty
+func (q *eventQueue) pop() (interface{}, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for len(q.events) == 0 && !q.closed {
+		q.notEmpty.Wait()
+	}
+	if len(q.events) == 0 {
+		return nil, false
+	}
+	event := q.events[0].event
+	q.events = q.events[1:]
+	q.notFull.Signal()
+	return event, true
+}
+
+func (q *eventQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.notEmpty.Broadcast()
+	q.notFull.Broadcast()
+}
+
+// eventKey returns symbol of event used to coalesce events, or empty string when event cannot be coalesced.
+// Diff. depth events are never coalesced, because every diff is needed to keep a local order book.
+func eventKey(event interface{}) string {
+	switch e := event.(type) {
+	case *TradeEvent:
+		return e.Symbol
+	case *AggregateTradeEvent:
+		return e.Symbol
+	case ChartEvent:
+		return e.Symbol
+	case TickerEvent:
+		return e.Symbol
+	case *OrderBook:
+		return e.Symbol
+	case *StreamEvent:
+		if isDiffDepthStream(e.Stream) {
+			return ""
+		}
+		return e.Stream
+	}
+	return ""
+}
+
+// deliver reads events with read until closed reports the stream ended and passes
+// them to out in another goroutine. finish is called after the last event was delivered.
+func deliver(opts DeliveryOptions, read func() (interface{}, error), closed func() bool, out func(interface{}), finish func()) *Delivery {
+	d := &Delivery{queue: newEventQueue(opts), done: make(chan struct{})}
+	go func() {
+		defer d.queue.close()
+		for {
+			event, err := read()
+			if err == nil {
+				d.queue.push(eventKey(event), event)
+				continue
+			}
+			if closed() {
+				d.err = err
+				return
+			}
+			if opts.OnError != nil {
+				opts.OnError(err)
+			}
+		}
+	}()
+	go func() {
+		defer close(d.done)
+		for {
+			event, ok := d.queue.pop()
+			if !ok {
+				break
+			}
+			out(event)
+		}
+		if finish != nil {
+			finish()
+		}
+	}()
+	return d
+}
+
+// deliver delivers events of the connection, which ends when the connection is closed
+func (s *streamConn) deliver(opts DeliveryOptions, read func() (interface{}, error), out func(interface{}), finish func()) *Delivery {
+	closed := func() bool {
+		return s.State() == StreamClosed
+	}
+	return deliver(opts, read, closed, out, finish)
+}
+
+// Events delivers aggregate trade events on returned channel, which is closed when the stream ends.
+// The channel has to be read until it is closed.
+func (s AggregateTradeStream) Events(opts DeliveryOptions) (<-chan *AggregateTradeEvent, *Delivery) {
+	ch := make(chan *AggregateTradeEvent)
+	d := s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { ch <- v.(*AggregateTradeEvent) }, func() { close(ch) })
+	return ch, d
+}
+
+// Handle calls fn with every aggregate trade event from a managed goroutine
+func (s AggregateTradeStream) Handle(opts DeliveryOptions, fn func(*AggregateTradeEvent)) *Delivery {
+	return s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { fn(v.(*AggregateTradeEvent)) }, nil)
+}
+
+// Events delivers trade events on returned channel, which is closed when the stream ends.
+// The channel has to be read until it is closed.
+func (s TradeStream) Events(opts DeliveryOptions) (<-chan *TradeEvent, *Delivery) {
+	ch := make(chan *TradeEvent)
+	d := s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { ch <- v.(*TradeEvent) }, func() { close(ch) })
+	return ch, d
+}
+
+// Handle calls fn with every trade event from a managed goroutine
+func (s TradeStream) Handle(opts DeliveryOptions, fn func(*TradeEvent)) *Delivery {
+	return s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { fn(v.(*TradeEvent)) }, nil)
+}
+
+// Events delivers kline events on returned channel, which is closed when the stream ends.
+// The channel has to be read until it is closed.
+func (s ChartStream) Events(opts DeliveryOptions) (<-chan ChartEvent, *Delivery) {
+	ch := make(chan ChartEvent)
+	d := s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { ch <- v.(ChartEvent) }, func() { close(ch) })
+	return ch, d
+}
+
+// Handle calls fn with every kline event from a managed goroutine
+func (s ChartStream) Handle(opts DeliveryOptions, fn func(ChartEvent)) *Delivery {
+	return s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { fn(v.(ChartEvent)) }, nil)
+}
+
+// Events delivers ticker events on returned channel, which is closed when the stream ends.
+// The channel has to be read until it is closed.
+func (s TickerStream) Events(opts DeliveryOptions) (<-chan TickerEvent, *Delivery) {
+	ch := make(chan TickerEvent)
+	d := s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { ch <- v.(TickerEvent) }, func() { close(ch) })
+	return ch, d
+}
+
+// Handle calls fn with every ticker event from a managed goroutine
+func (s TickerStream) Handle(opts DeliveryOptions, fn func(TickerEvent)) *Delivery {
+	return s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { fn(v.(TickerEvent)) }, nil)
+}
+
+// Events delivers tickers of all symbols on returned channel, which is closed when the stream ends.
+// The channel has to be read until it is closed.
+func (s TickersStream) Events(opts DeliveryOptions) (<-chan []TickerEvent, *Delivery) {
+	ch := make(chan []TickerEvent)
+	d := s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { ch <- v.([]TickerEvent) }, func() { close(ch) })
+	return ch, d
+}
+
+// Handle calls fn with tickers of all symbols from a managed goroutine
+func (s TickersStream) Handle(opts DeliveryOptions, fn func([]TickerEvent)) *Delivery {
+	return s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { fn(v.([]TickerEvent)) }, nil)
+}
+
+// Events delivers order books on returned channel, which is closed when the stream ends.
+// The channel has to be read until it is closed.
+func (s PartialBookStream) Events(opts DeliveryOptions) (<-chan *OrderBook, *Delivery) {
+	ch := make(chan *OrderBook)
+	d := s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { ch <- v.(*OrderBook) }, func() { close(ch) })
+	return ch, d
+}
+
+// Handle calls fn with every order book from a managed goroutine
+func (s PartialBookStream) Handle(opts DeliveryOptions, fn func(*OrderBook)) *Delivery {
+	return s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { fn(v.(*OrderBook)) }, nil)
+}
+
+// Events delivers order book updates on returned channel, which is closed when the stream ends.
+// The channel has to be read until it is closed.
+func (s DiffDepthStream) Events(opts DeliveryOptions) (<-chan *DiffDepth, *Delivery) {
+	ch := make(chan *DiffDepth)
+	d := s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { ch <- v.(*DiffDepth) }, func() { close(ch) })
+	return ch, d
+}
+
+// Handle calls fn with every order book update from a managed goroutine
+func (s DiffDepthStream) Handle(opts DeliveryOptions, fn func(*DiffDepth)) *Delivery {
+	return s.deliver(opts, func() (interface{}, error) { return s.Read() },
+		func(v interface{}) { fn(v.(*DiffDepth)) }, nil)
+}
+
+// Events delivers events of all streams on returned channel, which is closed when the stream ends.
+// The channel has to be read until it is closed.
+func (s *CombinedStream) Events(opts DeliveryOptions) (<-chan *StreamEvent, *Delivery) {
+	ch := make(chan *StreamEvent)
+	d := s.deliver(opts, func(v interface{}) { ch <- v.(*StreamEvent) }, func() { close(ch) })
+	return ch, d
+}
+
+// Handle calls fn with events of all streams from a managed goroutine
+func (s *CombinedStream) Handle(opts DeliveryOptions, fn func(*StreamEvent)) *Delivery {
+	return s.deliver(opts, func(v interface{}) { fn(v.(*StreamEvent)) }, nil)
+}
+
+// deliver delivers events of all connections, which ends when all of them are closed
+func (s *CombinedStream) deliver(opts DeliveryOptions, out func(interface{}), finish func()) *Delivery {
+	read := func() (interface{}, error) {
+		return s.Read()
+	}
+	closed := func() bool {
+		return s.ctx.Err() != nil
+	}
+	return deliver(opts, read, closed, out, finish)
+}
+
+// Events delivers user data events on returned channel, which is closed when the stream ends.
+// KeepaliveFailedEvent and ListenKeyRenewedEvent are delivered with the other events.
+// The channel has to be read until it is closed.
+func (s *UserDataStream) Events(opts DeliveryOptions) (<-chan UserDataEvent, *Delivery) {
+	ch := make(chan UserDataEvent)
+	d := s.deliver(opts, func(v interface{}) { ch <- v.(UserDataEvent) }, func() { close(ch) })
+	return ch, d
+}
+
+// Handle calls fn with every user data event from a managed goroutine
+func (s *UserDataStream) Handle(opts DeliveryOptions, fn func(UserDataEvent)) *Delivery {
+	return s.deliver(opts, func(v interface{}) { fn(v.(UserDataEvent)) }, nil)
+}
+
+// deliver delivers events of the stream, which ends when the stream is closed
+func (s *UserDataStream) deliver(opts DeliveryOptions, out func(interface{}), finish func()) *Delivery {
+	read := func() (interface{}, error) {
+		return s.Read()
+	}
+	closed := func() bool {
+		return s.ctx.Err() != nil
+	}
+	return deliver(opts, read, closed, out, finish)
+}
@@ -0,0 +1,168 @@
+package binance
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type pushedEvent struct {
+	key   string
+	event interface{}
+}
+
+func TestEventQueuePolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  BackpressurePolicy
+		pushed  []pushedEvent
+		want    []interface{}
+		dropped uint64
+	}{
+		{
+			name:    "drop oldest",
+			policy:  BackpressureDropOldest,
+			pushed:  []pushedEvent{{"A", 1}, {"B", 2}, {"C", 3}, {"D", 4}},
+			want:    []interface{}{3, 4},
+			dropped: 2,
+		},
+		{
+			name:    "drop newest",
+			policy:  BackpressureDropNewest,
+			pushed:  []pushedEvent{{"A", 1}, {"B", 2}, {"C", 3}, {"D", 4}},
+			want:    []interface{}{1, 2},
+			dropped: 2,
+		},
+		{
+			name:    "coalesce",
+			policy:  BackpressureCoalesce,
+			pushed:  []pushedEvent{{"A", 1}, {"B", 2}, {"A", 3}, {"B", 4}, {"A", 5}},
+			want:    []interface{}{5, 4},
+			dropped: 3,
+		},
+		{
+			name:   "coalesce without key",
+			policy: BackpressureCoalesce,
+			pushed: []pushedEvent{{"", 1}, {"", 2}},
+			want:   []interface{}{1, 2},
+		},
+		{
+			name:   "block within buffer",
+			policy: BackpressureBlock,
+			pushed: []pushedEvent{{"A", 1}, {"A", 2}},
+			want:   []interface{}{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newEventQueue(DeliveryOptions{Buffer: 2, Policy: tt.policy})
+			for _, e := range tt.pushed {
+				q.push(e.key, e.event)
+			}
+			q.close()
+			var got []interface{}
+			for {
+				event, ok := q.pop()
+				if !ok {
+					break
+				}
+				got = append(got, event)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got events %v, want %v", got, tt.want)
+			}
+			if q.dropped != tt.dropped {
+				t.Errorf("got %d dropped, want %d", q.dropped, tt.dropped)
+			}
+		})
+	}
+}
+
+func TestEventKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		event interface{}
+		key   string
+	}{
+		{name: "trade", event: &TradeEvent{Symbol: "BNBBTC"}, key: "BNBBTC"},
+		{name: "partial book", event: &OrderBook{Symbol: "BNBBTC"}, key: "BNBBTC"},
+		{name: "diff depth", event: &DiffDepth{Symbol: "BNBBTC"}},
+		{name: "combined partial book", event: &StreamEvent{Stream: "bnbbtc@depth5@100ms"}, key: "bnbbtc@depth5@100ms"},
+		{name: "combined diff depth", event: &StreamEvent{Stream: "bnbbtc@depth"}},
+		{name: "combined diff depth 100ms", event: &StreamEvent{Stream: "bnbbtc@depth@100ms"}},
+		{name: "user data", event: &BalanceUpdate{Asset: "BTC"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if key := eventKey(tt.event); key != tt.key {
+				t.Errorf("got key %q, want %q", key, tt.key)
+			}
+		})
+	}
+}
+
+// pushAsync pushes event in another goroutine, returning channel closed once push returns
+func pushAsync(q *eventQueue, key string, event interface{}) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		q.push(key, event)
+		close(done)
+	}()
+	return done
+}
+
+func TestEventQueueBlock(t *testing.T) {
+	for _, policy := range []BackpressurePolicy{BackpressureBlock, BackpressureCoalesce} {
+		q := newEventQueue(DeliveryOptions{Buffer: 1, Policy: policy})
+		q.push("A", 1)
+		done := pushAsync(q, "B", 2)
+		select {
+		case <-done:
+			t.Fatalf("policy %d: push to full queue did not block", policy)
+		case <-time.After(50 * time.Millisecond):
+		}
+		if event, ok := q.pop(); !ok || event != 1 {
+			t.Fatalf("policy %d: got %v, %v; want 1, true", policy, event, ok)
+		}
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatalf("policy %d: pop did not wake blocked push", policy)
+		}
+		if event, ok := q.pop(); !ok || event != 2 {
+			t.Fatalf("policy %d: got %v, %v; want 2, true", policy, event, ok)
+		}
+	}
+}
+
+func TestEventQueueCloseWakesPush(t *testing.T) {
+	q := newEventQueue(DeliveryOptions{Buffer: 1})
+	q.push("A", 1)
+	done := pushAsync(q, "A", 2)
+	time.Sleep(10 * time.Millisecond)
+	q.close()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("close did not wake blocked push")
+	}
+}
+
+func TestEventQueueCloseWakesPop(t *testing.T) {
+	q := newEventQueue(DeliveryOptions{})
+	done := make(chan bool)
+	go func() {
+		_, ok := q.pop()
+		done <- ok
+	}()
+	time.Sleep(10 * time.Millisecond)
+	q.close()
+	select {
+	case ok := <-done:
+		if ok {
+			t.Error("pop from closed empty queue reported an event")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("close did not wake blocked pop")
+	}
+}
@@ -95,6 +95,33 @@ const (
 	OrderStatusExpired = OrderStatus("EXPIRED")
 )
 
+// ExecutionType string
+type ExecutionType string
+
+// Execution types of order updates
+const (
+	// ExecutionTypeNew represents order accepted by the engine
+	ExecutionTypeNew = ExecutionType("NEW")
+
+	// ExecutionTypeCanceled represents order canceled by the user
+	ExecutionTypeCanceled = ExecutionType("CANCELED")
+
+	// ExecutionTypeReplaced represents order amended
+	ExecutionTypeReplaced = ExecutionType("REPLACED")
+
+	// ExecutionTypeRejected represents order rejected
+	ExecutionTypeRejected = ExecutionType("REJECTED")
+
+	// ExecutionTypeTrade represents part of the order or all of the order's quantity filled
+	ExecutionTypeTrade = ExecutionType("TRADE")
+
+	// ExecutionTypeExpired represents order canceled according to its rules, e.g. time in force
+	ExecutionTypeExpired = ExecutionType("EXPIRED")
+
+	// ExecutionTypeTradePrevention represents order expired due to self-trade prevention
+	ExecutionTypeTradePrevention = ExecutionType("TRADE_PREVENTION")
+)
+
 // OrderType string
 type OrderType string
 
@@ -118,6 +145,98 @@ const (
 	SellOrder = OrderSide("SELL")
 )
 
+// TimeInForce string
+type TimeInForce string
+
+// Time in force values
+const (
+	// GoodTillCanceled order remains active until it is filled or canceled
+	GoodTillCanceled = TimeInForce("GTC")
+
+	// ImmediateOrCancel order fills as much as it can and the rest expires
+	ImmediateOrCancel = TimeInForce("IOC")
+
+	// FillOrKill order expires unless it is filled completely
+	FillOrKill = TimeInForce("FOK")
+)
+
+// OrderResponseType string
+type OrderResponseType string
+
+// Order response types
+const (
+	AckResponse    = OrderResponseType("ACK")
+	ResultResponse = OrderResponseType("RESULT")
+	FullResponse   = OrderResponseType("FULL")
+)
+
+// CancelReplaceMode string
+type CancelReplaceMode string
+
+// Cancel-replace modes
+const (
+	// StopOnFailure does not place new order when cancel fails
+	StopOnFailure = CancelReplaceMode("STOP_ON_FAILURE")
+
+	// AllowFailure places new order even when cancel fails
+	AllowFailure = CancelReplaceMode("ALLOW_FAILURE")
+)
+
+// CancelReplaceStatus string
+type CancelReplaceStatus string
+
+// Cancel-replace operation results
+const (
+	CancelReplaceSuccess      = CancelReplaceStatus("SUCCESS")
+	CancelReplaceFailure      = CancelReplaceStatus("FAILURE")
+	CancelReplaceNotAttempted = CancelReplaceStatus("NOT_ATTEMPTED")
+)
+
+// ContingencyType string
+type ContingencyType string
+
+// Order list contingency types
+const (
+	// ContingencyOCO is One-Cancels-the-Other list
+	ContingencyOCO = ContingencyType("OCO")
+
+	// ContingencyOTO is One-Triggers-the-Other list
+	ContingencyOTO = ContingencyType("OTO")
+)
+
+// ListStatusType string
+type ListStatusType string
+
+// Order list status types
+const (
+	// ListStatusResponse is used when the list status is responding to a failed action, e.g. list rejected
+	ListStatusResponse = ListStatusType("RESPONSE")
+
+	// ListStatusExecStarted represents list that has been placed or has its status updated
+	ListStatusExecStarted = ListStatusType("EXEC_STARTED")
+
+	// ListStatusUpdated represents list whose orders have been updated
+	ListStatusUpdated = ListStatusType("UPDATED")
+
+	// ListStatusAllDone represents list that has finished executing and is no longer active
+	ListStatusAllDone = ListStatusType("ALL_DONE")
+)
+
+// ListOrderStatus string
+type ListOrderStatus string
+
+// Order list order statuses
+const (
+	// ListOrderStatusExecuting represents list that has been placed or has its status updated
+	ListOrderStatusExecuting = ListOrderStatus("EXECUTING")
+
+	// ListOrderStatusAllDone represents list that has finished executing and is no longer active
+	ListOrderStatusAllDone = ListOrderStatus("ALL_DONE")
+
+	// ListOrderStatusReject represents rejected list
+	ListOrderStatusReject = ListOrderStatus("REJECT")
+)
+
 // ChartInterval string
 type ChartInterval string
 
@@ -145,8 +264,10 @@ type RateLimiterType string
 
 // Rate limiter types
 const (
-	RequestsRLType = RateLimiterType("REQUESTS")
-	OrdersRLType   = RateLimiterType("ORDERS")
+	RequestsRLType      = RateLimiterType("REQUESTS")
+	RequestWeightRLType = RateLimiterType("REQUEST_WEIGHT")
+	RawRequestsRLType   = RateLimiterType("RAW_REQUESTS")
+	OrdersRLType        = RateLimiterType("ORDERS")
 )
 
 // RateLimitInterval string``
@@ -156,5 +277,6 @@ type RateLimitInterval string
 const (
 	SecondRLInterval = RateLimitInterval("SECOND")
 	MinuteRLInterval = RateLimitInterval("MINUTE")
+	HourRLInterval   = RateLimitInterval("HOUR")
 	DayRLInterval    = RateLimitInterval("DAY")
 )
@@ -0,0 +1,117 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Binance error codes
+const (
+	CodeDisconnected                 = -1001
+	CodeUnauthorized                 = -1002
+	CodeTooManyRequests              = -1003
+	CodeInvalidMessage               = -1013
+	CodeTooManyOrders                = -1015
+	CodeInvalidTimestamp             = -1021
+	CodeInvalidSignature             = -1022
+	CodeInvalidListenKey             = -1125
+	CodeNewOrderRejected             = -2010
+	CodeCancelRejected               = -2011
+	CodeNoSuchOrder                  = -2013
+	CodeRejectedMBXKey               = -2015
+	CodeCancelReplacePartiallyFailed = -2021
+	CodeCancelReplaceFailed          = -2022
+)
+
+// APIError is an error reported by Binance, e.g. an order rejected by exchange filters.
+// Use errors.As to get it from errors returned by the client.
+type APIError struct {
+	// HTTPStatus is HTTP status code of the response; 429 when rate limit is exceeded
+	// and 418 when the IP has been banned for exceeding it repeatedly
+	HTTPStatus int         `json:"-"`
+	Code       int         `json:"code"`
+	Message    string      `json:"msg"`
+	Header     http.Header `json:"-"`
+
+	// data holds additional error details some endpoints send, e.g. cancel-replace results
+	data []byte
+}
+
+func (e *APIError) Error() string {
+	if e.Code == 0 && e.HTTPStatus == 0 {
+		return "binance: " + e.Message
+	}
+	if e.Code == 0 {
+		return fmt.Sprintf("binance: %s (status %d)", e.Message, e.HTTPStatus)
+	}
+	return fmt.Sprintf("binance: %s (code %d)", e.Message, e.Code)
+}
+
+// IsUnknownOrder reports whether the order does not exist
+func (e *APIError) IsUnknownOrder() bool {
+	return e.Code == CodeNoSuchOrder ||
+		e.Code == CodeCancelRejected && strings.Contains(e.Message, "Unknown order")
+}
+
+// IsInsufficientBalance reports whether the account balance is too low for the order
+func (e *APIError) IsInsufficientBalance() bool {
+	return e.Code == CodeNewOrderRejected && strings.Contains(e.Message, "insufficient balance")
+}
+
+// IsInvalidTimestamp reports whether request timestamp is outside of the receive window
+func (e *APIError) IsInvalidTimestamp() bool {
+	return e.Code == CodeInvalidTimestamp
+}
+
+// IsTooManyRequests reports whether a request or order rate limit was exceeded
+func (e *APIError) IsTooManyRequests() bool {
+	return e.HTTPStatus == http.StatusTooManyRequests || e.Code == CodeTooManyRequests || e.Code == CodeTooManyOrders
+}
+
+// IsIPBanned reports whether the IP has been banned for exceeding rate limits
+func (e *APIError) IsIPBanned() bool {
+	return e.HTTPStatus == http.StatusTeapot
+}
+
+// IsFilterFailure reports whether the order was rejected by a symbol or exchange filter
+func (e *APIError) IsFilterFailure() bool {
+	return e.Code == CodeInvalidMessage && strings.HasPrefix(e.Message, "Filter failure")
+}
+
+// IsUnknownOrder reports whether err is an APIError for order that does not exist
+func IsUnknownOrder(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.IsUnknownOrder()
+}
+
+// IsInsufficientBalance reports whether err is an APIError for insufficient account balance
+func IsInsufficientBalance(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.IsInsufficientBalance()
+}
+
+// IsInvalidTimestamp reports whether err is an APIError for timestamp outside of the receive window
+func IsInvalidTimestamp(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.IsInvalidTimestamp()
+}
+
+// IsTooManyRequests reports whether err is an APIError for exceeded rate limit
+func IsTooManyRequests(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.IsTooManyRequests()
+}
+
+// IsIPBanned reports whether err is an APIError for banned IP
+func IsIPBanned(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.IsIPBanned()
+}
+
+// IsFilterFailure reports whether err is an APIError for order rejected by a filter
+func IsFilterFailure(err error) bool {
+	var e *APIError
+	return errors.As(err, &e) && e.IsFilterFailure()
+}
@@ -0,0 +1,144 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestErrorPredicates(t *testing.T) {
+	predicates := []struct {
+		name string
+		fn   func(error) bool
+	}{
+		{"IsUnknownOrder", IsUnknownOrder},
+		{"IsInsufficientBalance", IsInsufficientBalance},
+		{"IsInvalidTimestamp", IsInvalidTimestamp},
+		{"IsTooManyRequests", IsTooManyRequests},
+		{"IsIPBanned", IsIPBanned},
+		{"IsFilterFailure", IsFilterFailure},
+	}
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		code    int
+		message string
+		want    []string
+	}{
+		{
+			name:    "unknown order",
+			status:  http.StatusBadRequest,
+			body:    `{"code":-2011,"msg":"Unknown order sent."}`,
+			code:    CodeCancelRejected,
+			message: "Unknown order sent.",
+			want:    []string{"IsUnknownOrder"},
+		},
+		{
+			name:    "order does not exist",
+			status:  http.StatusBadRequest,
+			body:    `{"code":-2013,"msg":"Order does not exist."}`,
+			code:    CodeNoSuchOrder,
+			message: "Order does not exist.",
+			want:    []string{"IsUnknownOrder"},
+		},
+		{
+			name:    "cancel rejected",
+			status:  http.StatusBadRequest,
+			body:    `{"code":-2011,"msg":"Order was canceled or expired."}`,
+			code:    CodeCancelRejected,
+			message: "Order was canceled or expired.",
+		},
+		{
+			name:    "insufficient balance",
+			status:  http.StatusBadRequest,
+			body:    `{"code":-2010,"msg":"Account has insufficient balance for requested action."}`,
+			code:    CodeNewOrderRejected,
+			message: "Account has insufficient balance for requested action.",
+			want:    []string{"IsInsufficientBalance"},
+		},
+		{
+			name:    "invalid timestamp",
+			status:  http.StatusBadRequest,
+			body:    `{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`,
+			code:    CodeInvalidTimestamp,
+			message: "Timestamp for this request is outside of the recvWindow.",
+			want:    []string{"IsInvalidTimestamp"},
+		},
+		{
+			name:    "filter failure",
+			status:  http.StatusBadRequest,
+			body:    `{"code":-1013,"msg":"Filter failure: PRICE_FILTER"}`,
+			code:    CodeInvalidMessage,
+			message: "Filter failure: PRICE_FILTER",
+			want:    []string{"IsFilterFailure"},
+		},
+		{
+			name:    "too many requests",
+			status:  http.StatusTooManyRequests,
+			body:    `{"code":-1003,"msg":"Too many requests; current limit is 6000 request weight per 1 MINUTE."}`,
+			code:    CodeTooManyRequests,
+			message: "Too many requests; current limit is 6000 request weight per 1 MINUTE.",
+			want:    []string{"IsTooManyRequests"},
+		},
+		{
+			name:    "IP banned",
+			status:  http.StatusTeapot,
+			body:    `{"code":-1003,"msg":"Way too many requests; IP banned until 1659146400000."}`,
+			code:    CodeTooManyRequests,
+			message: "Way too many requests; IP banned until 1659146400000.",
+			want:    []string{"IsTooManyRequests", "IsIPBanned"},
+		},
+		{
+			name:    "gateway error",
+			status:  http.StatusBadGateway,
+			body:    "<html><body><h1>502 Bad Gateway</h1></body></html>\n",
+			message: "<html><body><h1>502 Bad Gateway</h1></body></html>",
+		},
+		{
+			name:    "empty body",
+			status:  http.StatusServiceUnavailable,
+			message: "Service Unavailable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+			err := NewClient(WithBaseURL(srv.URL)).Ping()
+			err = fmt.Errorf("wrapped: %w", err)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("got %v, want *APIError", err)
+			}
+			if apiErr.HTTPStatus != tt.status || apiErr.Code != tt.code || apiErr.Message != tt.message {
+				t.Errorf("got status %d, code %d, message %q; want %d, %d, %q",
+					apiErr.HTTPStatus, apiErr.Code, apiErr.Message, tt.status, tt.code, tt.message)
+			}
+			for _, p := range predicates {
+				want := false
+				for _, name := range tt.want {
+					want = want || name == p.name
+				}
+				if got := p.fn(err); got != want {
+					t.Errorf("%s() = %v, want %v", p.name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorPredicatesOtherErrors(t *testing.T) {
+	for _, err := range []error{nil, errors.New("binance: other"), ErrStreamClosed} {
+		if IsUnknownOrder(err) || IsInsufficientBalance(err) || IsInvalidTimestamp(err) ||
+			IsTooManyRequests(err) || IsIPBanned(err) || IsFilterFailure(err) {
+			t.Errorf("predicate matched %v", err)
+		}
+	}
+}
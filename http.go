@@ -1,28 +1,72 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 const (
-	addrPing                 = "https://api.binance.com/api/v1/ping"
-	addrServerTime           = "https://api.binance.com/api/v1/time"
-	addrExchangeInfo         = "https://api.binance.com/api/v1/exchangeInfo"
-	addrOrderBook            = "https://api.binance.com/api/v1/depth"
-	addrRecentTradesList     = "https://api.binance.com/api/v1/trades"
-	addrOldTradeLookup       = "https://api.binance.com/api/v1/historicalTrades"
-	addrAggregatedTrades     = "https://api.binance.com/api/v1/aggTrades"
-	addrKlineCandlestickData = "https://api.binance.com/api/v1/klines"
-	addrExchangeData24H      = "https://api.binance.com/api/v1/ticker/24hr"
-	addrSymbolPriceTicker    = "https://api.binance.com/api/v3/ticker/price"
-	addrBookTicker           = "https://api.binance.com/api/v3/ticker/bookTicker"
+	addrPing                 = "/api/v1/ping"
+	addrServerTime           = "/api/v1/time"
+	addrExchangeInfo         = "/api/v1/exchangeInfo"
+	addrOrderBook            = "/api/v1/depth"
+	addrRecentTradesList     = "/api/v3/trades"
+	addrOldTradeLookup       = "/api/v3/historicalTrades"
+	addrAggregatedTrades     = "/api/v3/aggTrades"
+	addrKlineCandlestickData = "/api/v1/klines"
+	addrExchangeData24H      = "/api/v1/ticker/24hr"
+	addrSymbolPriceTicker    = "/api/v3/ticker/price"
+	addrBookTicker           = "/api/v3/ticker/bookTicker"
+	addrOrder                = "/api/v3/order"
+	addrOrderTest            = "/api/v3/order/test"
+	addrCancelReplace        = "/api/v3/order/cancelReplace"
+	addrOpenOrders           = "/api/v3/openOrders"
+	addrAllOrders            = "/api/v3/allOrders"
+	addrAccount              = "/api/v3/account"
+	addrMyTrades             = "/api/v3/myTrades"
+	addrUserDataStream       = "/api/v3/userDataStream"
+	addrOrderListOCO         = "/api/v3/orderList/oco"
+	addrOrderListOTO         = "/api/v3/orderList/oto"
+	addrOrderListOTOCO       = "/api/v3/orderList/otoco"
+	addrOrderList            = "/api/v3/orderList"
+	addrAllOrderList         = "/api/v3/allOrderList"
+	addrOpenOrderList        = "/api/v3/openOrderList"
 )
 
 type params map[string]string
 
+// securityType defines how a request is authenticated
+type securityType int
+
+const (
+	// secNone requests are public
+	secNone securityType = iota
+	// secAPIKey requests carry the API key header
+	secAPIKey
+	// secSigned requests carry the API key header, a timestamp and a signature
+	secSigned
+)
+
+const headerAPIKey = "X-MBX-APIKEY"
+
+// ErrMissingCredentials is returned when an authenticated call is made by a client without credentials
+var ErrMissingCredentials = errors.New("binance: API credentials are required for this call")
+
+type request struct {
+	method string
+	path   string
+	params params
+	sec    securityType
+}
+
 func encodeQuery(u *url.URL, p params) {
 	q := u.Query()
 	for k, v := range p {
@@ -33,36 +77,131 @@ func encodeQuery(u *url.URL, p params) {
 	u.RawQuery = q.Encode()
 }
 
+func encodeParams(p params) string {
+	u := new(url.URL)
+	encodeQuery(u, p)
+	return u.RawQuery
+}
+
 func detectError(res *http.Response) error {
 	if res.StatusCode == 200 {
 		return nil
 	}
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
 	var reply struct {
-		Message string `json:"msg"`
+		APIError
+		Data json.RawMessage `json:"data"`
 	}
-	if err := json.NewDecoder(res.Body).Decode(&reply); err != nil {
-		return err
+	if err := json.Unmarshal(body, &reply); err != nil || reply.Message == "" {
+		// Errors sent by proxies and gateways are not JSON
+		reply.Message = strings.TrimSpace(string(body))
+		if reply.Message == "" {
+			reply.Message = http.StatusText(res.StatusCode)
+		}
+	}
+	apiErr := reply.APIError
+	apiErr.HTTPStatus = res.StatusCode
+	apiErr.Header = res.Header
+	apiErr.data = reply.Data
+	return &apiErr
+}
+
+// payload encodes request parameters, adding timestamp, receive window
+// and signature for signed requests.
+func (c *Client) payload(r *request) (string, error) {
+	if r.sec != secSigned {
+		return encodeParams(r.params), nil
+	}
+	p := params{"timestamp": formatTime(c.clock.now())}
+	if c.recvWindow > 0 {
+		p["recvWindow"] = strconv.FormatInt(int64(c.recvWindow/time.Millisecond), 10)
+	}
+	for k, v := range r.params {
+		p[k] = v
+	}
+	return signParams(c.signer, p)
+}
+
+func (c *Client) newRequest(ctx context.Context, r *request) (*http.Request, error) {
+	if r.sec != secNone && c.apiKey == "" || r.sec == secSigned && c.signer == nil {
+		return nil, ErrMissingCredentials
+	}
+	payload, err := c.payload(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader
+	addr := c.baseURL + r.path
+	hasBody := r.method == http.MethodPost || r.method == http.MethodPut
+	if hasBody {
+		body = strings.NewReader(payload)
+	} else if payload != "" {
+		addr += "?" + payload
+	}
+	req, err := http.NewRequestWithContext(ctx, r.method, addr, body)
+	if err != nil {
+		return nil, err
+	}
+	if hasBody {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	if r.sec != secNone {
+		req.Header.Set(headerAPIKey, c.apiKey)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
 	}
-	return errors.New(reply.Message)
+	return req, nil
 }
 
-func fetch(url string, p params, reply interface{}) error {
-	req, err := http.NewRequest("GET", url, nil)
+// do sends r and decodes response into reply, retrying failed attempts when retry policy allows it.
+// Signed requests rejected for their timestamp are sent once more after time is synced.
+func (c *Client) do(ctx context.Context, r *request, reply interface{}) error {
+	send := func() error {
+		err := c.send(ctx, r, reply)
+		if err == nil {
+			return nil
+		}
+		return c.resyncOnTimestampError(ctx, r, err, func() error {
+			return c.send(ctx, r, reply)
+		})
+	}
+	if c.retry == nil {
+		return send()
+	}
+	return c.retry.do(ctx, r, send)
+}
+
+// send makes single attempt of r
+func (c *Client) send(ctx context.Context, r *request, reply interface{}) error {
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx, r); err != nil {
+			return err
+		}
+	}
+	// Request is built after waiting for the limiter, so signed requests carry a fresh timestamp
+	req, err := c.newRequest(ctx, r)
 	if err != nil {
 		return err
 	}
-	if p != nil {
-		encodeQuery(req.URL, p)
-	}
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.httpClient.Do(req)
 	if err != nil {
 		return err
 	}
 	defer res.Body.Close()
 
+	c.usage.update(res.Header, time.Now())
 	if err := detectError(res); err != nil {
 		return err
 	}
 	return json.NewDecoder(res.Body).Decode(&reply)
 }
+
+func (c *Client) fetch(ctx context.Context, path string, p params, reply interface{}) error {
+	return c.do(ctx, &request{method: http.MethodGet, path: path, params: p}, reply)
+}
@@ -0,0 +1,111 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// klineFieldCount is the number of array elements decoded by Kline.UnmarshalJSON
+const klineFieldCount = 11
+
+// klineValues holds decimal values of a kline as received from Binance
+type klineValues struct {
+	open, high, low, close, volume     string
+	quoteVolume, takerBase, takerQuote string
+}
+
+// parse sets decimal values of k
+func (v *klineValues) parse(k *Kline) error {
+	fields := [...]struct {
+		name string
+		s    string
+		dst  *float64
+	}{
+		{"open price", v.open, &k.Open},
+		{"high price", v.high, &k.High},
+		{"low price", v.low, &k.Low},
+		{"close price", v.close, &k.Close},
+		{"volume", v.volume, &k.Volume},
+		{"quote asset volume", v.quoteVolume, &k.QuoteAssetVolume},
+		{"taker buy base asset volume", v.takerBase, &k.TakerBuyBaseAssetVol},
+		{"taker buy quote asset volume", v.takerQuote, &k.TakerBuyQuoteAssetVol},
+	}
+	for _, f := range fields {
+		x, err := strconv.ParseFloat(f.s, 64)
+		if err != nil {
+			return fmt.Errorf("binance: invalid kline %s %q", f.name, f.s)
+		}
+		*f.dst = x
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes kline from array form returned by klines endpoint.
+// Open and close times are in milliseconds.
+func (k *Kline) UnmarshalJSON(data []byte) error {
+	var openTime, closeTime int64
+	var v klineValues
+	// Elements are decoded straight into the variables they point to
+	fields := []interface{}{
+		&openTime, &v.open, &v.high, &v.low, &v.close, &v.volume,
+		&closeTime, &v.quoteVolume, &k.TradesCount, &v.takerBase, &v.takerQuote,
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("binance: invalid kline: %v", err)
+	}
+	if len(fields) < klineFieldCount {
+		return fmt.Errorf("binance: kline has %d fields, want at least %d", len(fields), klineFieldCount)
+	}
+	k.OpenTime = msTime(openTime)
+	k.CloseTime = msTime(closeTime)
+	return v.parse(k)
+}
+
+// UnmarshalJSON decodes kline from object form sent by kline/candlestick stream
+func (k *ChartKline) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		OpenTime     int64         `json:"t"`
+		CloseTime    int64         `json:"T"`
+		Symbol       string        `json:"s"`
+		Interval     ChartInterval `json:"i"`
+		FirstTradeID int64         `json:"f"`
+		LastTradeID  int64         `json:"L"`
+		Open         string        `json:"o"`
+		Close        string        `json:"c"`
+		High         string        `json:"h"`
+		Low          string        `json:"l"`
+		Volume       string        `json:"v"`
+		TradesCount  int           `json:"n"`
+		IsClosed     bool          `json:"x"`
+		QuoteVolume  string        `json:"q"`
+		TakerBase    string        `json:"V"`
+		TakerQuote   string        `json:"Q"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("binance: invalid kline: %v", err)
+	}
+	v := klineValues{
+		open:        raw.Open,
+		high:        raw.High,
+		low:         raw.Low,
+		close:       raw.Close,
+		volume:      raw.Volume,
+		quoteVolume: raw.QuoteVolume,
+		takerBase:   raw.TakerBase,
+		takerQuote:  raw.TakerQuote,
+	}
+	*k = ChartKline{
+		Kline: Kline{
+			OpenTime:    msTime(raw.OpenTime),
+			CloseTime:   msTime(raw.CloseTime),
+			TradesCount: raw.TradesCount,
+		},
+		Symbol:       raw.Symbol,
+		Interval:     raw.Interval,
+		FirstTradeID: raw.FirstTradeID,
+		LastTradeID:  raw.LastTradeID,
+		IsClosed:     raw.IsClosed,
+	}
+	return v.parse(&k.Kline)
+}
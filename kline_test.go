@@ -0,0 +1,184 @@
+package binance
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestKlineUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want Kline
+		err  string
+	}{
+		{
+			name: "valid",
+			data: `[1499040000000,"0.01634790","0.80000000","0.01575800","0.01577100","148976.11427815",
+				1499644799999,"2434.19055334",308,"1756.87402397","28.46694368","17928899.62484339"]`,
+			want: Kline{
+				OpenTime:              time.Unix(0, 1499040000000*int64(time.Millisecond)),
+				Open:                  0.0163479,
+				High:                  0.8,
+				Low:                   0.015758,
+				Close:                 0.015771,
+				Volume:                148976.11427815,
+				CloseTime:             time.Unix(0, 1499644799999*int64(time.Millisecond)),
+				QuoteAssetVolume:      2434.19055334,
+				TradesCount:           308,
+				TakerBuyBaseAssetVol:  1756.87402397,
+				TakerBuyQuoteAssetVol: 28.46694368,
+			},
+		},
+		{
+			name: "short row",
+			data: `[1499040000000,"0.01634790","0.80000000","0.01575800","0.01577100"]`,
+			err:  "binance: kline has 5 fields, want at least 11",
+		},
+		{
+			name: "string time",
+			data: `["1499040000000","0.01634790","0.80000000","0.01575800","0.01577100","148976.11427815",
+				1499644799999,"2434.19055334",308,"1756.87402397","28.46694368","0"]`,
+			err: "binance: invalid kline: ",
+		},
+		{
+			name: "number price",
+			data: `[1499040000000,0.0163479,"0.80000000","0.01575800","0.01577100","148976.11427815",
+				1499644799999,"2434.19055334",308,"1756.87402397","28.46694368","0"]`,
+			err: "binance: invalid kline: ",
+		},
+		{
+			name: "invalid price",
+			data: `[1499040000000,"0.01634790","0.80000000","0.01575800","0.01577100","-",
+				1499644799999,"2434.19055334",308,"1756.87402397","28.46694368","0"]`,
+			err: `binance: invalid kline volume "-"`,
+		},
+		{
+			name: "null price",
+			data: `[1499040000000,null,"0.80000000","0.01575800","0.01577100","148976.11427815",
+				1499644799999,"2434.19055334",308,"1756.87402397","28.46694368","0"]`,
+			err: `binance: invalid kline open price ""`,
+		},
+		{
+			name: "null trades count",
+			data: `[1499040000000,"1","1","1","1","1",1499644799999,"1",null,"1","1","0"]`,
+			want: Kline{
+				OpenTime:              time.Unix(0, 1499040000000*int64(time.Millisecond)),
+				Open:                  1,
+				High:                  1,
+				Low:                   1,
+				Close:                 1,
+				Volume:                1,
+				CloseTime:             time.Unix(0, 1499644799999*int64(time.Millisecond)),
+				QuoteAssetVolume:      1,
+				TakerBuyBaseAssetVol:  1,
+				TakerBuyQuoteAssetVol: 1,
+			},
+		},
+		{
+			name: "object",
+			data: `{"openTime":1499040000000}`,
+			err:  "binance: invalid kline: ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var k Kline
+			err := json.Unmarshal([]byte(tt.data), &k)
+			if tt.err != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+					t.Fatalf("got error %v, want %s", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if k != tt.want {
+				t.Errorf("got %+v, want %+v", k, tt.want)
+			}
+		})
+	}
+}
+
+func TestChartKlineUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want ChartKline
+		err  string
+	}{
+		{
+			name: "valid",
+			data: `{"t":123400000,"T":123460000,"s":"BNBBTC","i":"1m","f":100,"L":200,"o":"0.0010",
+				"c":"0.0020","h":"0.0025","l":"0.0015","v":"1000","n":100,"x":false,"q":"1.0000",
+				"V":"500","Q":"0.500","B":"123456"}`,
+			want: ChartKline{
+				Kline: Kline{
+					OpenTime:              time.Unix(0, 123400000*int64(time.Millisecond)),
+					Open:                  0.001,
+					High:                  0.0025,
+					Low:                   0.0015,
+					Close:                 0.002,
+					Volume:                1000,
+					CloseTime:             time.Unix(0, 123460000*int64(time.Millisecond)),
+					QuoteAssetVolume:      1,
+					TradesCount:           100,
+					TakerBuyBaseAssetVol:  500,
+					TakerBuyQuoteAssetVol: 0.5,
+				},
+				Symbol:       "BNBBTC",
+				Interval:     "1m",
+				FirstTradeID: 100,
+				LastTradeID:  200,
+			},
+		},
+		{
+			name: "missing fields",
+			data: `{"t":123400000,"T":123460000,"s":"BNBBTC","i":"1m"}`,
+			err:  `binance: invalid kline open price ""`,
+		},
+		{
+			name: "number price",
+			data: `{"t":123400000,"o":0.001}`,
+			err:  "binance: invalid kline: ",
+		},
+		{
+			name: "null price",
+			data: `{"t":123400000,"T":123460000,"o":null,"c":"0.0020","h":"0.0025","l":"0.0015",
+				"v":"1000","q":"1.0000","V":"500","Q":"0.500"}`,
+			err: `binance: invalid kline open price ""`,
+		},
+		{
+			name: "invalid price",
+			data: `{"t":123400000,"T":123460000,"o":"0.0010","c":"0.0020","h":"high","l":"0.0015",
+				"v":"1000","q":"1.0000","V":"500","Q":"0.500"}`,
+			err: `binance: invalid kline high price "high"`,
+		},
+		{
+			name: "array",
+			data: `[123400000]`,
+			err:  "binance: invalid kline: ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var k ChartKline
+			err := json.Unmarshal([]byte(tt.data), &k)
+			if tt.err != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+					t.Fatalf("got error %v, want %s", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if k != tt.want {
+				t.Errorf("got %+v, want %+v", k, tt.want)
+			}
+		})
+	}
+}
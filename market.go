@@ -0,0 +1,169 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	minDepthLimit = 5
+	maxDepthLimit = 5000
+	maxListLimit  = 1000
+)
+
+var errMissingInterval = errors.New("binance: interval is required")
+
+// validateLimit checks limit of endpoints returning up to maxListLimit entries
+func validateLimit(limit int) error {
+	if limit < 0 || limit > maxListLimit {
+		return fmt.Errorf("binance: limit %d is out of range, want 0 (default) or 1-%d", limit, maxListLimit)
+	}
+	return nil
+}
+
+func validateTimeRange(start, end time.Time) error {
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return errors.New("binance: end time is before start time")
+	}
+	return nil
+}
+
+func (r *OrderBookRequest) validate() error {
+	if r.Symbol == "" {
+		return errMissingSymbol
+	}
+	if r.Limit != 0 && (r.Limit < minDepthLimit || r.Limit > maxDepthLimit) {
+		return fmt.Errorf("binance: depth limit %d is out of range, want 0 (default) or %d-%d", r.Limit, minDepthLimit, maxDepthLimit)
+	}
+	return nil
+}
+
+func (r *AggregateTradesRequest) validate() error {
+	if r.Symbol == "" {
+		return errMissingSymbol
+	}
+	if r.FromID < 0 {
+		return fmt.Errorf("binance: invalid from ID %d", r.FromID)
+	}
+	if err := validateTimeRange(r.StartTime, r.EndTime); err != nil {
+		return err
+	}
+	if !r.StartTime.IsZero() && !r.EndTime.IsZero() && r.EndTime.Sub(r.StartTime) >= maxAggregateTradesRange {
+		return errors.New("binance: time between start time and end time must be less than one hour")
+	}
+	return validateLimit(r.Limit)
+}
+
+func (r *AggregateTradesRequest) params() params {
+	return params{
+		"symbol":    r.Symbol,
+		"fromId":    formatInt(r.FromID),
+		"startTime": formatTime(r.StartTime),
+		"endTime":   formatTime(r.EndTime),
+		"limit":     formatInt(int64(r.Limit)),
+	}
+}
+
+func (r *KlinesRequest) validate() error {
+	switch {
+	case r.Symbol == "":
+		return errMissingSymbol
+	case r.Interval == "":
+		return errMissingInterval
+	}
+	if err := validateTimeRange(r.StartTime, r.EndTime); err != nil {
+		return err
+	}
+	return validateLimit(r.Limit)
+}
+
+func (r *KlinesRequest) params() params {
+	return params{
+		"symbol":    r.Symbol,
+		"interval":  string(r.Interval),
+		"startTime": formatTime(r.StartTime),
+		"endTime":   formatTime(r.EndTime),
+		"limit":     formatInt(int64(r.Limit)),
+	}
+}
+
+// QueryOrderBook is like GetOrderBook but takes typed parameters
+func (c *Client) QueryOrderBook(req *OrderBookRequest) (*OrderBook, error) {
+	return c.QueryOrderBookContext(context.Background(), req)
+}
+
+// QueryOrderBookContext is like QueryOrderBook but uses ctx for the request
+func (c *Client) QueryOrderBookContext(ctx context.Context, req *OrderBookRequest) (*OrderBook, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	return c.fetchOrderBook(ctx, req.Symbol, formatInt(int64(req.Limit)))
+}
+
+// QueryTrades is like GetRecentTrades but takes typed parameters
+func (c *Client) QueryTrades(req *TradesRequest) ([]Trade, error) {
+	return c.QueryTradesContext(context.Background(), req)
+}
+
+// QueryTradesContext is like QueryTrades but uses ctx for the request
+func (c *Client) QueryTradesContext(ctx context.Context, req *TradesRequest) (list []Trade, err error) {
+	if req.Symbol == "" {
+		return nil, errMissingSymbol
+	}
+	if err = validateLimit(req.Limit); err != nil {
+		return nil, err
+	}
+	p := params{"symbol": req.Symbol, "limit": formatInt(int64(req.Limit))}
+	err = c.fetch(ctx, addrRecentTradesList, p, &list)
+	return
+}
+
+// QueryHistoricalTrades is like GetOldTrades but takes typed parameters
+func (c *Client) QueryHistoricalTrades(req *HistoricalTradesRequest) ([]Trade, error) {
+	return c.QueryHistoricalTradesContext(context.Background(), req)
+}
+
+// QueryHistoricalTradesContext is like QueryHistoricalTrades but uses ctx for the request
+func (c *Client) QueryHistoricalTradesContext(ctx context.Context, req *HistoricalTradesRequest) (list []Trade, err error) {
+	if req.Symbol == "" {
+		return nil, errMissingSymbol
+	}
+	if req.FromID < 0 {
+		return nil, fmt.Errorf("binance: invalid from ID %d", req.FromID)
+	}
+	if err = validateLimit(req.Limit); err != nil {
+		return nil, err
+	}
+	p := params{"symbol": req.Symbol, "fromId": formatInt(req.FromID), "limit": formatInt(int64(req.Limit))}
+	err = c.fetch(ctx, addrOldTradeLookup, p, &list)
+	return
+}
+
+// QueryAggregateTrades is like GetAggregateTrades but takes typed parameters
+func (c *Client) QueryAggregateTrades(req *AggregateTradesRequest) ([]AggregateTrade, error) {
+	return c.QueryAggregateTradesContext(context.Background(), req)
+}
+
+// QueryAggregateTradesContext is like QueryAggregateTrades but uses ctx for the request
+func (c *Client) QueryAggregateTradesContext(ctx context.Context, req *AggregateTradesRequest) (list []AggregateTrade, err error) {
+	if err = req.validate(); err != nil {
+		return nil, err
+	}
+	err = c.fetch(ctx, addrAggregatedTrades, req.params(), &list)
+	return
+}
+
+// QueryKlines is like GetKlines but takes typed parameters
+func (c *Client) QueryKlines(req *KlinesRequest) ([]Kline, error) {
+	return c.QueryKlinesContext(context.Background(), req)
+}
+
+// QueryKlinesContext is like QueryKlines but uses ctx for the request
+func (c *Client) QueryKlinesContext(ctx context.Context, req *KlinesRequest) ([]Kline, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	return c.fetchKlines(ctx, req.params())
+}
@@ -0,0 +1,65 @@
+package binance
+
+import (
+	"testing"
+	"time"
+)
+
+type validator interface {
+	validate() error
+}
+
+func TestRequestValidate(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		req   validator
+		valid bool
+	}{
+		{name: "order book default limit", req: &OrderBookRequest{Symbol: "BNBBTC"}, valid: true},
+		{name: "order book limit below minimum", req: &OrderBookRequest{Symbol: "BNBBTC", Limit: 4}},
+		{name: "order book minimum limit", req: &OrderBookRequest{Symbol: "BNBBTC", Limit: 5}, valid: true},
+		{name: "order book maximum limit", req: &OrderBookRequest{Symbol: "BNBBTC", Limit: 5000}, valid: true},
+		{name: "order book limit above maximum", req: &OrderBookRequest{Symbol: "BNBBTC", Limit: 5001}},
+		{name: "order book negative limit", req: &OrderBookRequest{Symbol: "BNBBTC", Limit: -1}},
+		{name: "order book without symbol", req: &OrderBookRequest{Limit: 100}},
+		{name: "klines maximum limit", req: &KlinesRequest{Symbol: "BNBBTC", Interval: ChartIntervalOneMin, Limit: 1000}, valid: true},
+		{name: "klines limit above maximum", req: &KlinesRequest{Symbol: "BNBBTC", Interval: ChartIntervalOneMin, Limit: 1001}},
+		{name: "klines without interval", req: &KlinesRequest{Symbol: "BNBBTC"}},
+		{name: "klines end before start", req: &KlinesRequest{Symbol: "BNBBTC", Interval: ChartIntervalOneMin, StartTime: start, EndTime: start.Add(-time.Millisecond)}},
+		{
+			name:  "aggregate trades range under one hour",
+			req:   &AggregateTradesRequest{Symbol: "BNBBTC", StartTime: start, EndTime: start.Add(time.Hour - time.Millisecond)},
+			valid: true,
+		},
+		{name: "aggregate trades range of one hour", req: &AggregateTradesRequest{Symbol: "BNBBTC", StartTime: start, EndTime: start.Add(time.Hour)}},
+		{name: "aggregate trades negative from ID", req: &AggregateTradesRequest{Symbol: "BNBBTC", FromID: -1}},
+		{name: "aggregate trades negative limit", req: &AggregateTradesRequest{Symbol: "BNBBTC", Limit: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.req.validate(); (err == nil) != tt.valid {
+				t.Errorf("validate() = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestValidateOrderBook(t *testing.T) {
+	tests := []struct {
+		limit string
+		valid bool
+	}{
+		{"", true},
+		{"4", false},
+		{"5", true},
+		{"5000", true},
+		{"5001", false},
+		{"ten", false},
+	}
+	for _, tt := range tests {
+		if err := validateOrderBook("BNBBTC", tt.limit); (err == nil) != tt.valid {
+			t.Errorf("validateOrderBook(%q) = %v, want valid %v", tt.limit, err, tt.valid)
+		}
+	}
+}
@@ -1,11 +1,15 @@
 package binance
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // RateLimit struct
 type RateLimit struct {
 	RateLimitType RateLimiterType   `json:"rateLimitType"`
 	Interval      RateLimitInterval `json:"interval"`
+	IntervalNum   int               `json:"intervalNum"`
 	Limit         int               `json:"limit"`
 }
 
@@ -59,56 +63,161 @@ type OrderBook struct {
 
 // Trade represents raw trade information
 type Trade struct {
-	ID           uint64 `json:"id"`
-	Price        string `json:"price"`
-	Quantity     string `json:"qty"`
-	Time         uint64 `json:"time"`
-	IsBuyerMaker bool   `json:"isBuyerMaker"`
-	IsBestMatch  bool   `json:"isBestMatch"`
+	ID            uint64    `json:"id"`
+	Price         float64   `json:"price,string"`
+	Quantity      float64   `json:"qty,string"`
+	QuoteQuantity float64   `json:"quoteQty,string"`
+	Time          time.Time `json:"time"`
+	IsBuyerMaker  bool      `json:"isBuyerMaker"`
+	IsBestMatch   bool      `json:"isBestMatch"`
 }
 
-// TradeEvent struct
+// UnmarshalJSON decodes trade with time in milliseconds
+func (t *Trade) UnmarshalJSON(data []byte) error {
+	type alias Trade
+	raw := struct {
+		*alias
+		Time int64 `json:"time"`
+	}{alias: (*alias)(t)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	t.Time = msTime(raw.Time)
+	return nil
+}
+
+// TradeEvent represents trade received from trade stream
 type TradeEvent struct {
-	EventType     string  `json:"e"`
-	EventTime     uint64  `json:"E"`
-	Symbol        string  `json:"s"`
-	TradeID       uint64  `json:"t"`
-	Price         float64 `json:"p,string"`
-	Quantity      float64 `json:"q,string"`
-	BuyerOrderID  uint64  `json:"b"`
-	SellerOrderID uint64  `json:"a"`
-	TradeTime     int64   `json:"T"`
-	IsBuyerMaker  bool    `json:"m"`
-}
-
-// AggregateTrade struct
+	EventType     string    `json:"e"`
+	EventTime     time.Time `json:"E"`
+	Symbol        string    `json:"s"`
+	TradeID       uint64    `json:"t"`
+	Price         float64   `json:"p,string"`
+	Quantity      float64   `json:"q,string"`
+	BuyerOrderID  uint64    `json:"b"`
+	SellerOrderID uint64    `json:"a"`
+	TradeTime     time.Time `json:"T"`
+	IsBuyerMaker  bool      `json:"m"`
+	Ignore        bool      `json:"M"`
+}
+
+// UnmarshalJSON decodes trade event with times in milliseconds
+func (e *TradeEvent) UnmarshalJSON(data []byte) error {
+	type alias TradeEvent
+	raw := struct {
+		*alias
+		EventTime int64 `json:"E"`
+		TradeTime int64 `json:"T"`
+	}{alias: (*alias)(e)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	e.EventTime = msTime(raw.EventTime)
+	e.TradeTime = msTime(raw.TradeTime)
+	return nil
+}
+
+// AggregateTrade represents trades that filled at the same time,
+// from the same taker order, with the same price
 type AggregateTrade struct {
-	ID               uint64  `json:"a"`
-	Price            float64 `json:"p"`
-	Quantity         float64 `json:"q"`
-	FirstTradeID     uint64  `json:"f"`
-	LastTradeID      uint64  `json:"l"`
-	Timestamp        uint64  `json:"T"`
-	IsBuyerMaker     bool    `json:"m"`
-	IsBestPriceMatch bool    `json:"M"`
+	ID               uint64    `json:"a"`
+	Price            float64   `json:"p,string"`
+	Quantity         float64   `json:"q,string"`
+	FirstTradeID     uint64    `json:"f"`
+	LastTradeID      uint64    `json:"l"`
+	Time             time.Time `json:"T"`
+	IsBuyerMaker     bool      `json:"m"`
+	IsBestPriceMatch bool      `json:"M"`
+}
+
+// UnmarshalJSON decodes aggregate trade with time in milliseconds
+func (t *AggregateTrade) UnmarshalJSON(data []byte) error {
+	type alias AggregateTrade
+	raw := struct {
+		*alias
+		Time int64 `json:"T"`
+	}{alias: (*alias)(t)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	t.Time = msTime(raw.Time)
+	return nil
 }
 
-// AggregateTradeEvent struct
+// AggregateTradeEvent represents aggregate trade received from aggregate trade stream
 type AggregateTradeEvent struct {
-	EventType     string      `json:"e"`
-	EventTime     uint64      `json:"E"`
-	Symbol        string      `json:"s"`
-	TradeID       uint64      `json:"t"`
-	Price         float64     `json:"p,string"`
-	Quantity      float64     `json:"q,string"`
-	BuyerOrderID  uint64      `json:"b"`
-	SellerOrderID uint64      `json:"a"`
-	TradeTime     uint64      `json:"T"`
-	IsMarketMaker bool        `json:"m"`
-	Ignore        interface{} `json:"M"`
-}
-
-// Kline struct
+	EventType        string    `json:"e"`
+	EventTime        time.Time `json:"E"`
+	Symbol           string    `json:"s"`
+	AggregateTradeID uint64    `json:"a"`
+	Price            float64   `json:"p,string"`
+	Quantity         float64   `json:"q,string"`
+	FirstTradeID     uint64    `json:"f"`
+	LastTradeID      uint64    `json:"l"`
+	TradeTime        time.Time `json:"T"`
+	IsBuyerMaker     bool      `json:"m"`
+	Ignore           bool      `json:"M"`
+}
+
+// UnmarshalJSON decodes aggregate trade event with times in milliseconds
+func (e *AggregateTradeEvent) UnmarshalJSON(data []byte) error {
+	type alias AggregateTradeEvent
+	raw := struct {
+		*alias
+		EventTime int64 `json:"E"`
+		TradeTime int64 `json:"T"`
+	}{alias: (*alias)(e)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	e.EventTime = msTime(raw.EventTime)
+	e.TradeTime = msTime(raw.TradeTime)
+	return nil
+}
+
+// OrderBookRequest selects order book returned by QueryOrderBook.
+// Limit must be between 5 and 5000; Binance returns 100 levels when it is zero.
+type OrderBookRequest struct {
+	Symbol string
+	Limit  int
+}
+
+// TradesRequest selects trades returned by QueryTrades.
+// Limit is at most 1000; Binance returns 500 trades when it is zero.
+type TradesRequest struct {
+	Symbol string
+	Limit  int
+}
+
+// HistoricalTradesRequest selects trades returned by QueryHistoricalTrades.
+// Most recent trades are returned when FromID is zero.
+type HistoricalTradesRequest struct {
+	Symbol string
+	FromID int64
+	Limit  int
+}
+
+// AggregateTradesRequest selects trades returned by QueryAggregateTrades.
+// Time between StartTime and EndTime must be less than one hour.
+type AggregateTradesRequest struct {
+	Symbol    string
+	FromID    int64
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+}
+
+// KlinesRequest selects klines returned by QueryKlines.
+// Limit is at most 1000; Binance returns 500 klines when it is zero.
+type KlinesRequest struct {
+	Symbol    string
+	Interval  ChartInterval
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+}
+
+// Kline represents kline/candlestick bar
 type Kline struct {
 	OpenTime              time.Time
 	Open                  float64
@@ -125,27 +234,21 @@ type Kline struct {
 
 // ChartEvent represents updates to the current klines/candlestick
 type ChartEvent struct {
-	EventType string `json:"e"`
-	EventTime uint64 `json:"E"`
-	Symbol    string `json:"s"`
-	Kline     struct {
-		KlineStart               uint64 `json:"t"`
-		KlineClose               uint64 `json:"T"`
-		Symbol                   string `json:"s"`
-		Interval                 string `json:"i"`
-		FirstTradeID             uint64 `json:"f"`
-		LastTradeID              uint64 `json:"L"`
-		OpenPrice                string `json:"o"`
-		ClosePrice               string `json:"c"`
-		HighPrice                string `json:"h"`
-		LowPrice                 string `json:"l"`
-		BaseAssetVolume          string `json:"v"`
-		NumberOfTrades           int    `json:"n"`
-		IsClosed                 bool   `json:"x"`
-		QuoteAssetVolume         string `json:"q"`
-		TakerBuyBaseAssetVolume  string `json:"V"`
-		TakerBuyQuoteAssetVolume string `json:"Q"`
-	} `json:"k"`
+	EventType string     `json:"e"`
+	EventTime uint64     `json:"E"`
+	Symbol    string     `json:"s"`
+	Kline     ChartKline `json:"k"`
+}
+
+// ChartKline represents kline received from kline/candlestick stream.
+// The kline is final when IsClosed is set.
+type ChartKline struct {
+	Kline
+	Symbol       string
+	Interval     ChartInterval
+	FirstTradeID int64
+	LastTradeID  int64
+	IsClosed     bool
 }
 
 // Ticker represents 24 hour price change statistics
@@ -223,3 +326,446 @@ type DiffDepth struct {
 	Bids          []Order
 	Asks          []Order
 }
+
+// OrderRequest describes new order.
+// Zero values are left out of the request.
+type OrderRequest struct {
+	Symbol           string
+	Side             OrderSide
+	Type             OrderType
+	TimeInForce      TimeInForce
+	Quantity         float64
+	QuoteOrderQty    float64
+	Price            float64
+	StopPrice        float64
+	IcebergQty       float64
+	NewClientOrderID string
+	NewOrderRespType OrderResponseType
+}
+
+// Fill represents a trade that filled an order
+type Fill struct {
+	TradeID         int64   `json:"tradeId"`
+	Price           float64 `json:"price,string"`
+	Quantity        float64 `json:"qty,string"`
+	Commission      float64 `json:"commission,string"`
+	CommissionAsset string  `json:"commissionAsset"`
+}
+
+// OrderResponse represents placed order. Fields filled depend on the response type;
+// ACK carries only identifiers, RESULT adds order state and FULL adds fills.
+type OrderResponse struct {
+	Symbol              string      `json:"symbol"`
+	OrderID             int64       `json:"orderId"`
+	OrderListID         int64       `json:"orderListId"`
+	ClientOrderID       string      `json:"clientOrderId"`
+	TransactTime        int64       `json:"transactTime"`
+	Price               float64     `json:"price,string"`
+	OrigQty             float64     `json:"origQty,string"`
+	ExecutedQty         float64     `json:"executedQty,string"`
+	CummulativeQuoteQty float64     `json:"cummulativeQuoteQty,string"`
+	Status              OrderStatus `json:"status"`
+	TimeInForce         TimeInForce `json:"timeInForce"`
+	Type                OrderType   `json:"type"`
+	Side                OrderSide   `json:"side"`
+	Fills               []Fill      `json:"fills"`
+}
+
+// CommissionRates represents maker and taker commission rates
+type CommissionRates struct {
+	Maker float64 `json:"maker,string"`
+	Taker float64 `json:"taker,string"`
+}
+
+// CommissionDiscount represents commission discount paid with discount asset
+type CommissionDiscount struct {
+	EnabledForAccount bool    `json:"enabledForAccount"`
+	EnabledForSymbol  bool    `json:"enabledForSymbol"`
+	DiscountAsset     string  `json:"discountAsset"`
+	Discount          float64 `json:"discount,string"`
+}
+
+// TestOrderResult represents result of a validated test order.
+// Commission rates are set only when requested.
+type TestOrderResult struct {
+	StandardCommission *CommissionRates    `json:"standardCommissionForOrder"`
+	TaxCommission      *CommissionRates    `json:"taxCommissionForOrder"`
+	Discount           *CommissionDiscount `json:"discount"`
+}
+
+// OrderQuery identifies an order on a symbol either by order ID or by client order ID
+type OrderQuery struct {
+	Symbol            string
+	OrderID           int64
+	OrigClientOrderID string
+}
+
+// AllOrdersRequest selects orders returned by GetAllOrders.
+// When OrderID is set, orders with ID >= OrderID are returned,
+// otherwise the most recent orders are returned.
+type AllOrdersRequest struct {
+	Symbol    string
+	OrderID   int64
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+}
+
+// OrderInfo represents current state of an order
+type OrderInfo struct {
+	Symbol              string      `json:"symbol"`
+	OrderID             int64       `json:"orderId"`
+	OrderListID         int64       `json:"orderListId"`
+	ClientOrderID       string      `json:"clientOrderId"`
+	OrigClientOrderID   string      `json:"origClientOrderId"`
+	Price               float64     `json:"price,string"`
+	OrigQty             float64     `json:"origQty,string"`
+	ExecutedQty         float64     `json:"executedQty,string"`
+	CummulativeQuoteQty float64     `json:"cummulativeQuoteQty,string"`
+	OrigQuoteOrderQty   float64     `json:"origQuoteOrderQty,string"`
+	Status              OrderStatus `json:"status"`
+	TimeInForce         TimeInForce `json:"timeInForce"`
+	Type                OrderType   `json:"type"`
+	Side                OrderSide   `json:"side"`
+	StopPrice           float64     `json:"stopPrice,string"`
+	IcebergQty          float64     `json:"icebergQty,string"`
+	Time                int64       `json:"time"`
+	UpdateTime          int64       `json:"updateTime"`
+	TransactTime        int64       `json:"transactTime"`
+	IsWorking           bool        `json:"isWorking"`
+}
+
+// ListOrder describes one order of an order list.
+// Side and Quantity are used only where the list request does not set them for all orders.
+type ListOrder struct {
+	Type          OrderType
+	Side          OrderSide
+	ClientOrderID string
+	Quantity      float64
+	Price         float64
+	StopPrice     float64
+	IcebergQty    float64
+	TimeInForce   TimeInForce
+}
+
+// OCORequest describes new One-Cancels-the-Other order list.
+// Above order is placed above the current price and Below order below it.
+type OCORequest struct {
+	Symbol            string
+	ListClientOrderID string
+	Side              OrderSide
+	Quantity          float64
+	Above             ListOrder
+	Below             ListOrder
+	NewOrderRespType  OrderResponseType
+}
+
+// OTORequest describes new One-Triggers-the-Other order list.
+// Pending order is placed once Working order is fully filled.
+type OTORequest struct {
+	Symbol            string
+	ListClientOrderID string
+	Working           ListOrder
+	Pending           ListOrder
+	NewOrderRespType  OrderResponseType
+}
+
+// OTOCORequest describes new One-Triggers-One-Cancels-the-Other order list.
+// Pending OCO pair is placed once Working order is fully filled.
+type OTOCORequest struct {
+	Symbol            string
+	ListClientOrderID string
+	Working           ListOrder
+	PendingSide       OrderSide
+	PendingQuantity   float64
+	PendingAbove      ListOrder
+	PendingBelow      ListOrder
+	NewOrderRespType  OrderResponseType
+}
+
+// OrderListQuery identifies an order list either by list ID or by list client order ID
+type OrderListQuery struct {
+	Symbol            string
+	OrderListID       int64
+	ListClientOrderID string
+}
+
+// AllOrderListsRequest selects order lists returned by GetAllOrderLists.
+// FromID cannot be combined with time range.
+type AllOrderListsRequest struct {
+	FromID    int64
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+}
+
+// OrderListEntry identifies order of an order list
+type OrderListEntry struct {
+	Symbol        string `json:"symbol"`
+	OrderID       int64  `json:"orderId"`
+	ClientOrderID string `json:"clientOrderId"`
+}
+
+// OrderList represents order list state.
+// OrderReports are set only in responses to placing and canceling lists.
+type OrderList struct {
+	OrderListID       int64            `json:"orderListId"`
+	ContingencyType   ContingencyType  `json:"contingencyType"`
+	ListStatusType    ListStatusType   `json:"listStatusType"`
+	ListOrderStatus   ListOrderStatus  `json:"listOrderStatus"`
+	ListClientOrderID string           `json:"listClientOrderId"`
+	TransactionTime   int64            `json:"transactionTime"`
+	Symbol            string           `json:"symbol"`
+	Orders            []OrderListEntry `json:"orders"`
+	OrderReports      []OrderInfo      `json:"orderReports"`
+}
+
+// CancelReplaceRequest describes order to cancel and new order to place in its place.
+// Order to cancel is identified either by CancelOrderID or by CancelOrigClientOrderID.
+type CancelReplaceRequest struct {
+	OrderRequest
+	Mode                    CancelReplaceMode
+	CancelOrderID           int64
+	CancelOrigClientOrderID string
+	CancelNewClientOrderID  string
+}
+
+// CancelReplaceResult represents outcome of both cancel-replace operations.
+// CancelResponse is set when cancel succeeded and CancelError when it failed;
+// NewOrderResponse and NewOrderError follow the same rule for the new order.
+type CancelReplaceResult struct {
+	CancelResult     CancelReplaceStatus
+	NewOrderResult   CancelReplaceStatus
+	CancelResponse   *OrderInfo
+	CancelError      *APIError
+	NewOrderResponse *OrderResponse
+	NewOrderError    *APIError
+}
+
+// CancelSucceeded reports whether the order was canceled
+func (r *CancelReplaceResult) CancelSucceeded() bool {
+	return r.CancelResult == CancelReplaceSuccess
+}
+
+// NewOrderSucceeded reports whether the new order was placed
+func (r *CancelReplaceResult) NewOrderSucceeded() bool {
+	return r.NewOrderResult == CancelReplaceSuccess
+}
+
+// Balance represents account balance of an asset
+type Balance struct {
+	Asset  string  `json:"asset"`
+	Free   float64 `json:"free,string"`
+	Locked float64 `json:"locked,string"`
+}
+
+// AccountCommissionRates represents commission rates of an account
+type AccountCommissionRates struct {
+	Maker  float64 `json:"maker,string"`
+	Taker  float64 `json:"taker,string"`
+	Buyer  float64 `json:"buyer,string"`
+	Seller float64 `json:"seller,string"`
+}
+
+// Account represents current account information
+type Account struct {
+	MakerCommission            int                    `json:"makerCommission"`
+	TakerCommission            int                    `json:"takerCommission"`
+	BuyerCommission            int                    `json:"buyerCommission"`
+	SellerCommission           int                    `json:"sellerCommission"`
+	CommissionRates            AccountCommissionRates `json:"commissionRates"`
+	CanTrade                   bool                   `json:"canTrade"`
+	CanWithdraw                bool                   `json:"canWithdraw"`
+	CanDeposit                 bool                   `json:"canDeposit"`
+	Brokered                   bool                   `json:"brokered"`
+	RequireSelfTradePrevention bool                   `json:"requireSelfTradePrevention"`
+	UpdateTime                 int64                  `json:"updateTime"`
+	AccountType                string                 `json:"accountType"`
+	Balances                   []Balance              `json:"balances"`
+	Permissions                []string               `json:"permissions"`
+	UID                        int64                  `json:"uid"`
+}
+
+// MyTradesRequest selects trades returned by GetMyTrades.
+// FromID cannot be combined with time range.
+type MyTradesRequest struct {
+	Symbol    string
+	OrderID   int64
+	StartTime time.Time
+	EndTime   time.Time
+	FromID    int64
+	Limit     int
+}
+
+// AccountTrade represents trade of the account
+type AccountTrade struct {
+	Symbol          string    `json:"symbol"`
+	ID              uint64    `json:"id"`
+	OrderID         int64     `json:"orderId"`
+	OrderListID     int64     `json:"orderListId"`
+	Price           float64   `json:"price,string"`
+	Quantity        float64   `json:"qty,string"`
+	QuoteQuantity   float64   `json:"quoteQty,string"`
+	Commission      float64   `json:"commission,string"`
+	CommissionAsset string    `json:"commissionAsset"`
+	Time            time.Time `json:"time"`
+	IsBuyer         bool      `json:"isBuyer"`
+	IsMaker         bool      `json:"isMaker"`
+	IsBestMatch     bool      `json:"isBestMatch"`
+}
+
+// UnmarshalJSON decodes account trade with time in milliseconds
+func (t *AccountTrade) UnmarshalJSON(data []byte) error {
+	type alias AccountTrade
+	raw := struct {
+		*alias
+		Time int64 `json:"time"`
+	}{alias: (*alias)(t)}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	t.Time = msTime(raw.Time)
+	return nil
+}
+
+// UserDataEvent is an event read from UserDataStream
+type UserDataEvent interface {
+	// EventName returns event type, e.g. "executionReport"
+	EventName() string
+}
+
+// RawUserDataEvent represents user data event as received from Binance
+type RawUserDataEvent struct {
+	EventType string          `json:"e"`
+	EventTime int64           `json:"E"`
+	Data      json.RawMessage `json:"-"`
+}
+
+// EventName returns event type
+func (e *RawUserDataEvent) EventName() string { return e.EventType }
+
+// ListenKeyExpiredEvent is sent when the stream listen key expires.
+// The stream then obtains a fresh listen key and reconnects.
+type ListenKeyExpiredEvent struct {
+	EventType string `json:"e"`
+	EventTime int64  `json:"E"`
+	ListenKey string `json:"listenKey"`
+}
+
+// EventName returns event type
+func (e *ListenKeyExpiredEvent) EventName() string { return e.EventType }
+
+// ListenKeyRenewedEvent is emitted after the stream reconnected with a fresh listen key.
+// Events sent between expiry and reconnect are lost.
+type ListenKeyRenewedEvent struct {
+	ListenKey string
+}
+
+// EventName returns event type
+func (e *ListenKeyRenewedEvent) EventName() string { return "listenKeyRenewed" }
+
+// KeepaliveFailedEvent is emitted when listen key keepalive fails.
+// When the listen key is unknown to Binance the stream obtains a fresh key and reconnects.
+type KeepaliveFailedEvent struct {
+	ListenKey string
+	Err       error
+}
+
+// EventName returns event type
+func (e *KeepaliveFailedEvent) EventName() string { return "keepaliveFailed" }
+
+// ExecutionReport is sent when an order is updated.
+// Last* fields describe the trade that caused TRADE execution.
+type ExecutionReport struct {
+	EventType           string        `json:"e"`
+	EventTime           int64         `json:"E"`
+	Symbol              string        `json:"s"`
+	ClientOrderID       string        `json:"c"`
+	Side                OrderSide     `json:"S"`
+	Type                OrderType     `json:"o"`
+	TimeInForce         TimeInForce   `json:"f"`
+	Quantity            float64       `json:"q,string"`
+	Price               float64       `json:"p,string"`
+	StopPrice           float64       `json:"P,string"`
+	IcebergQty          float64       `json:"F,string"`
+	OrderListID         int64         `json:"g"`
+	OrigClientOrderID   string        `json:"C"`
+	ExecutionType       ExecutionType `json:"x"`
+	Status              OrderStatus   `json:"X"`
+	RejectReason        string        `json:"r"`
+	OrderID             int64         `json:"i"`
+	LastExecutedQty     float64       `json:"l,string"`
+	CumulativeFilledQty float64       `json:"z,string"`
+	LastExecutedPrice   float64       `json:"L,string"`
+	Commission          float64       `json:"n,string"`
+	CommissionAsset     string        `json:"N"`
+	TransactionTime     int64         `json:"T"`
+	TradeID             int64         `json:"t"`
+	IsWorking           bool          `json:"w"`
+	IsMaker             bool          `json:"m"`
+	CreationTime        int64         `json:"O"`
+	CumulativeQuoteQty  float64       `json:"Z,string"`
+	LastQuoteQty        float64       `json:"Y,string"`
+	QuoteOrderQty       float64       `json:"Q,string"`
+	WorkingTime         int64         `json:"W"`
+	IgnoreI             interface{}   `json:"I"`
+	IgnoreM             interface{}   `json:"M"`
+}
+
+// EventName returns event type
+func (e *ExecutionReport) EventName() string { return e.EventType }
+
+// PositionBalance represents asset balance in account position update
+type PositionBalance struct {
+	Asset  string  `json:"a"`
+	Free   float64 `json:"f,string"`
+	Locked float64 `json:"l,string"`
+}
+
+// AccountPositionUpdate is sent when account balance changes and contains assets that were changed
+type AccountPositionUpdate struct {
+	EventType      string            `json:"e"`
+	EventTime      int64             `json:"E"`
+	LastUpdateTime int64             `json:"u"`
+	Balances       []PositionBalance `json:"B"`
+}
+
+// EventName returns event type
+func (e *AccountPositionUpdate) EventName() string { return e.EventType }
+
+// BalanceUpdate is sent on deposits, withdrawals and transfers
+type BalanceUpdate struct {
+	EventType string  `json:"e"`
+	EventTime int64   `json:"E"`
+	Asset     string  `json:"a"`
+	Delta     float64 `json:"d,string"`
+	ClearTime int64   `json:"T"`
+}
+
+// EventName returns event type
+func (e *BalanceUpdate) EventName() string { return e.EventType }
+
+// ListStatusOrder identifies order of an order list in list status update
+type ListStatusOrder struct {
+	Symbol        string `json:"s"`
+	OrderID       int64  `json:"i"`
+	ClientOrderID string `json:"c"`
+}
+
+// ListStatusEvent is sent when an order list is updated, alongside execution reports of its orders
+type ListStatusEvent struct {
+	EventType         string            `json:"e"`
+	EventTime         int64             `json:"E"`
+	Symbol            string            `json:"s"`
+	OrderListID       int64             `json:"g"`
+	ContingencyType   ContingencyType   `json:"c"`
+	ListStatusType    ListStatusType    `json:"l"`
+	ListOrderStatus   ListOrderStatus   `json:"L"`
+	RejectReason      string            `json:"r"`
+	ListClientOrderID string            `json:"C"`
+	TransactionTime   int64             `json:"T"`
+	Orders            []ListStatusOrder `json:"O"`
+}
+
+// EventName returns event type
+func (e *ListStatusEvent) EventName() string { return e.EventType }
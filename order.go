@@ -0,0 +1,181 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+var errMissingSymbol = errors.New("binance: symbol is required")
+
+func (r *OrderRequest) validate() error {
+	switch {
+	case r.Symbol == "":
+		return errMissingSymbol
+	case r.Side == "":
+		return errors.New("binance: order side is required")
+	case r.Type == "":
+		return errors.New("binance: order type is required")
+	case r.Quantity != 0 && r.QuoteOrderQty != 0:
+		return errors.New("binance: order quantity and quote order quantity are mutually exclusive")
+	case r.Type != MarketOrder && r.QuoteOrderQty != 0:
+		return errors.New("binance: quote order quantity is allowed only for market orders")
+	}
+	return nil
+}
+
+func (r *OrderRequest) params() params {
+	return params{
+		"symbol":           r.Symbol,
+		"side":             string(r.Side),
+		"type":             string(r.Type),
+		"timeInForce":      string(r.TimeInForce),
+		"quantity":         formatFloat(r.Quantity),
+		"quoteOrderQty":    formatFloat(r.QuoteOrderQty),
+		"price":            formatFloat(r.Price),
+		"stopPrice":        formatFloat(r.StopPrice),
+		"icebergQty":       formatFloat(r.IcebergQty),
+		"newClientOrderId": r.NewClientOrderID,
+		"newOrderRespType": string(r.NewOrderRespType),
+	}
+}
+
+// PlaceOrder sends new order
+func (c *Client) PlaceOrder(req *OrderRequest) (*OrderResponse, error) {
+	return c.PlaceOrderContext(context.Background(), req)
+}
+
+// PlaceOrderContext is like PlaceOrder but uses ctx for the request
+func (c *Client) PlaceOrderContext(ctx context.Context, req *OrderRequest) (*OrderResponse, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	r := &request{method: http.MethodPost, path: addrOrder, params: req.params(), sec: secSigned}
+	res := new(OrderResponse)
+	if err := c.do(ctx, r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TestOrder validates new order against exchange rules without sending it to the matching engine.
+// Rejected orders are reported as *APIError. When computeCommissionRates is set
+// the result contains commission rates the order would be charged.
+func (c *Client) TestOrder(req *OrderRequest, computeCommissionRates bool) (*TestOrderResult, error) {
+	return c.TestOrderContext(context.Background(), req, computeCommissionRates)
+}
+
+// TestOrderContext is like TestOrder but uses ctx for the request
+func (c *Client) TestOrderContext(ctx context.Context, req *OrderRequest, computeCommissionRates bool) (*TestOrderResult, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+	p := req.params()
+	if computeCommissionRates {
+		p["computeCommissionRates"] = strconv.FormatBool(computeCommissionRates)
+	}
+	r := &request{method: http.MethodPost, path: addrOrderTest, params: p, sec: secSigned}
+	res := new(TestOrderResult)
+	if err := c.do(ctx, r, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (q *OrderQuery) params() (params, error) {
+	if q.Symbol == "" {
+		return nil, errMissingSymbol
+	}
+	if q.OrderID == 0 && q.OrigClientOrderID == "" {
+		return nil, errors.New("binance: order ID or client order ID is required")
+	}
+	return params{
+		"symbol":            q.Symbol,
+		"orderId":           formatInt(q.OrderID),
+		"origClientOrderId": q.OrigClientOrderID,
+	}, nil
+}
+
+// QueryOrder gets order status
+func (c *Client) QueryOrder(q *OrderQuery) (*OrderInfo, error) {
+	return c.QueryOrderContext(context.Background(), q)
+}
+
+// QueryOrderContext is like QueryOrder but uses ctx for the request
+func (c *Client) QueryOrderContext(ctx context.Context, q *OrderQuery) (*OrderInfo, error) {
+	return c.orderInfo(ctx, http.MethodGet, q)
+}
+
+// CancelOrder cancels an active order
+func (c *Client) CancelOrder(q *OrderQuery) (*OrderInfo, error) {
+	return c.CancelOrderContext(context.Background(), q)
+}
+
+// CancelOrderContext is like CancelOrder but uses ctx for the request
+func (c *Client) CancelOrderContext(ctx context.Context, q *OrderQuery) (*OrderInfo, error) {
+	return c.orderInfo(ctx, http.MethodDelete, q)
+}
+
+func (c *Client) orderInfo(ctx context.Context, method string, q *OrderQuery) (*OrderInfo, error) {
+	p, err := q.params()
+	if err != nil {
+		return nil, err
+	}
+	r := &request{method: method, path: addrOrder, params: p, sec: secSigned}
+	info := new(OrderInfo)
+	if err := c.do(ctx, r, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// CancelOpenOrders cancels all active orders on a symbol
+func (c *Client) CancelOpenOrders(symbol string) ([]OrderInfo, error) {
+	return c.CancelOpenOrdersContext(context.Background(), symbol)
+}
+
+// CancelOpenOrdersContext is like CancelOpenOrders but uses ctx for the request
+func (c *Client) CancelOpenOrdersContext(ctx context.Context, symbol string) (list []OrderInfo, err error) {
+	if symbol == "" {
+		return nil, errMissingSymbol
+	}
+	r := &request{method: http.MethodDelete, path: addrOpenOrders, params: params{"symbol": symbol}, sec: secSigned}
+	err = c.do(ctx, r, &list)
+	return
+}
+
+// GetOpenOrders gets all open orders on a symbol or on all symbols when symbol is empty.
+// Querying all symbols has much higher weight.
+func (c *Client) GetOpenOrders(symbol string) ([]OrderInfo, error) {
+	return c.GetOpenOrdersContext(context.Background(), symbol)
+}
+
+// GetOpenOrdersContext is like GetOpenOrders but uses ctx for the request
+func (c *Client) GetOpenOrdersContext(ctx context.Context, symbol string) (list []OrderInfo, err error) {
+	r := &request{method: http.MethodGet, path: addrOpenOrders, params: params{"symbol": symbol}, sec: secSigned}
+	err = c.do(ctx, r, &list)
+	return
+}
+
+// GetAllOrders gets all account orders; active, canceled, or filled
+func (c *Client) GetAllOrders(req *AllOrdersRequest) ([]OrderInfo, error) {
+	return c.GetAllOrdersContext(context.Background(), req)
+}
+
+// GetAllOrdersContext is like GetAllOrders but uses ctx for the request
+func (c *Client) GetAllOrdersContext(ctx context.Context, req *AllOrdersRequest) (list []OrderInfo, err error) {
+	if req.Symbol == "" {
+		return nil, errMissingSymbol
+	}
+	p := params{
+		"symbol":    req.Symbol,
+		"orderId":   formatInt(req.OrderID),
+		"startTime": formatTime(req.StartTime),
+		"endTime":   formatTime(req.EndTime),
+		"limit":     formatInt(int64(req.Limit)),
+	}
+	r := &request{method: http.MethodGet, path: addrAllOrders, params: p, sec: secSigned}
+	err = c.do(ctx, r, &list)
+	return
+}
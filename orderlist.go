@@ -0,0 +1,185 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+var errMissingOrderListID = errors.New("binance: order list ID or list client order ID is required")
+
+// addParams adds order parameters to p with keys prefixed by prefix, e.g. "aboveType"
+func (o *ListOrder) addParams(p params, prefix string) {
+	p[prefix+"Type"] = string(o.Type)
+	p[prefix+"Side"] = string(o.Side)
+	p[prefix+"ClientOrderId"] = o.ClientOrderID
+	p[prefix+"Quantity"] = formatFloat(o.Quantity)
+	p[prefix+"Price"] = formatFloat(o.Price)
+	p[prefix+"StopPrice"] = formatFloat(o.StopPrice)
+	p[prefix+"IcebergQty"] = formatFloat(o.IcebergQty)
+	p[prefix+"TimeInForce"] = string(o.TimeInForce)
+}
+
+// PlaceOCO sends new One-Cancels-the-Other order list
+func (c *Client) PlaceOCO(req *OCORequest) (*OrderList, error) {
+	return c.PlaceOCOContext(context.Background(), req)
+}
+
+// PlaceOCOContext is like PlaceOCO but uses ctx for the request
+func (c *Client) PlaceOCOContext(ctx context.Context, req *OCORequest) (*OrderList, error) {
+	if req.Symbol == "" {
+		return nil, errMissingSymbol
+	}
+	p := params{
+		"symbol":            req.Symbol,
+		"listClientOrderId": req.ListClientOrderID,
+		"side":              string(req.Side),
+		"quantity":          formatFloat(req.Quantity),
+		"newOrderRespType":  string(req.NewOrderRespType),
+	}
+	req.Above.addParams(p, "above")
+	req.Below.addParams(p, "below")
+	return c.placeOrderList(ctx, addrOrderListOCO, p)
+}
+
+// PlaceOTO sends new One-Triggers-the-Other order list
+func (c *Client) PlaceOTO(req *OTORequest) (*OrderList, error) {
+	return c.PlaceOTOContext(context.Background(), req)
+}
+
+// PlaceOTOContext is like PlaceOTO but uses ctx for the request
+func (c *Client) PlaceOTOContext(ctx context.Context, req *OTORequest) (*OrderList, error) {
+	if req.Symbol == "" {
+		return nil, errMissingSymbol
+	}
+	p := params{
+		"symbol":            req.Symbol,
+		"listClientOrderId": req.ListClientOrderID,
+		"newOrderRespType":  string(req.NewOrderRespType),
+	}
+	req.Working.addParams(p, "working")
+	req.Pending.addParams(p, "pending")
+	return c.placeOrderList(ctx, addrOrderListOTO, p)
+}
+
+// PlaceOTOCO sends new One-Triggers-One-Cancels-the-Other order list
+func (c *Client) PlaceOTOCO(req *OTOCORequest) (*OrderList, error) {
+	return c.PlaceOTOCOContext(context.Background(), req)
+}
+
+// PlaceOTOCOContext is like PlaceOTOCO but uses ctx for the request
+func (c *Client) PlaceOTOCOContext(ctx context.Context, req *OTOCORequest) (*OrderList, error) {
+	if req.Symbol == "" {
+		return nil, errMissingSymbol
+	}
+	p := params{
+		"symbol":            req.Symbol,
+		"listClientOrderId": req.ListClientOrderID,
+		"newOrderRespType":  string(req.NewOrderRespType),
+		"pendingSide":       string(req.PendingSide),
+		"pendingQuantity":   formatFloat(req.PendingQuantity),
+	}
+	req.Working.addParams(p, "working")
+	req.PendingAbove.addParams(p, "pendingAbove")
+	req.PendingBelow.addParams(p, "pendingBelow")
+	return c.placeOrderList(ctx, addrOrderListOTOCO, p)
+}
+
+func (c *Client) placeOrderList(ctx context.Context, path string, p params) (*OrderList, error) {
+	r := &request{method: http.MethodPost, path: path, params: p, sec: secSigned}
+	list := new(OrderList)
+	if err := c.do(ctx, r, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// CancelOrderList cancels all orders of an order list
+func (c *Client) CancelOrderList(q *OrderListQuery) (*OrderList, error) {
+	return c.CancelOrderListContext(context.Background(), q)
+}
+
+// CancelOrderListContext is like CancelOrderList but uses ctx for the request
+func (c *Client) CancelOrderListContext(ctx context.Context, q *OrderListQuery) (*OrderList, error) {
+	if q.Symbol == "" {
+		return nil, errMissingSymbol
+	}
+	if q.OrderListID == 0 && q.ListClientOrderID == "" {
+		return nil, errMissingOrderListID
+	}
+	p := params{
+		"symbol":            q.Symbol,
+		"orderListId":       formatInt(q.OrderListID),
+		"listClientOrderId": q.ListClientOrderID,
+	}
+	r := &request{method: http.MethodDelete, path: addrOrderList, params: p, sec: secSigned}
+	list := new(OrderList)
+	if err := c.do(ctx, r, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// QueryOrderList gets order list status. Symbol of the query is not used.
+func (c *Client) QueryOrderList(q *OrderListQuery) (*OrderList, error) {
+	return c.QueryOrderListContext(context.Background(), q)
+}
+
+// QueryOrderListContext is like QueryOrderList but uses ctx for the request
+func (c *Client) QueryOrderListContext(ctx context.Context, q *OrderListQuery) (*OrderList, error) {
+	if q.OrderListID == 0 && q.ListClientOrderID == "" {
+		return nil, errMissingOrderListID
+	}
+	p := params{
+		"orderListId":       formatInt(q.OrderListID),
+		"origClientOrderId": q.ListClientOrderID,
+	}
+	r := &request{method: http.MethodGet, path: addrOrderList, params: p, sec: secSigned}
+	list := new(OrderList)
+	if err := c.do(ctx, r, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// GetAllOrderLists gets order lists of all symbols
+func (c *Client) GetAllOrderLists(req *AllOrderListsRequest) ([]OrderList, error) {
+	return c.GetAllOrderListsContext(context.Background(), req)
+}
+
+var errFromIDWithTimeRange = errors.New("binance: from ID cannot be combined with time range")
+
+func (r *AllOrderListsRequest) validate() error {
+	if r.FromID != 0 && (!r.StartTime.IsZero() || !r.EndTime.IsZero()) {
+		return errFromIDWithTimeRange
+	}
+	return nil
+}
+
+// GetAllOrderListsContext is like GetAllOrderLists but uses ctx for the request
+func (c *Client) GetAllOrderListsContext(ctx context.Context, req *AllOrderListsRequest) (list []OrderList, err error) {
+	if err = req.validate(); err != nil {
+		return nil, err
+	}
+	p := params{
+		"fromId":    formatInt(req.FromID),
+		"startTime": formatTime(req.StartTime),
+		"endTime":   formatTime(req.EndTime),
+		"limit":     formatInt(int64(req.Limit)),
+	}
+	r := &request{method: http.MethodGet, path: addrAllOrderList, params: p, sec: secSigned}
+	err = c.do(ctx, r, &list)
+	return
+}
+
+// GetOpenOrderLists gets open order lists of all symbols
+func (c *Client) GetOpenOrderLists() ([]OrderList, error) {
+	return c.GetOpenOrderListsContext(context.Background())
+}
+
+// GetOpenOrderListsContext is like GetOpenOrderLists but uses ctx for the request
+func (c *Client) GetOpenOrderListsContext(ctx context.Context) (list []OrderList, err error) {
+	r := &request{method: http.MethodGet, path: addrOpenOrderList, sec: secSigned}
+	err = c.do(ctx, r, &list)
+	return
+}
@@ -0,0 +1,161 @@
+package binance
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// Responses from Binance API documentation of order list endpoints
+const (
+	ocoResponse = `{
+		"orderListId": 1,
+		"contingencyType": "OCO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "lH1YDkuQKWiXVXHPSKYEIp",
+		"transactionTime": 1710485608839,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 10, "clientOrderId": "44nZvqpemY7sVYgPYbvPih"},
+			{"symbol": "LTCBTC", "orderId": 11, "clientOrderId": "NuMp0nVYnciDiFmVqfpBqK"}
+		],
+		"orderReports": [
+			{
+				"symbol": "LTCBTC", "orderId": 10, "orderListId": 1, "clientOrderId": "44nZvqpemY7sVYgPYbvPih",
+				"transactTime": 1710485608839, "price": "1.00000000", "origQty": "5.00000000",
+				"executedQty": "0.00000000", "cummulativeQuoteQty": "0.00000000", "status": "NEW",
+				"timeInForce": "GTC", "type": "STOP_LOSS_LIMIT", "side": "SELL", "stopPrice": "1.00000000",
+				"workingTime": -1, "selfTradePreventionMode": "NONE"
+			},
+			{
+				"symbol": "LTCBTC", "orderId": 11, "orderListId": 1, "clientOrderId": "NuMp0nVYnciDiFmVqfpBqK",
+				"transactTime": 1710485608839, "price": "3.00000000", "origQty": "5.00000000",
+				"executedQty": "0.00000000", "cummulativeQuoteQty": "0.00000000", "status": "NEW",
+				"timeInForce": "GTC", "type": "LIMIT_MAKER", "side": "SELL",
+				"workingTime": 1710485608839, "selfTradePreventionMode": "NONE"
+			}
+		]
+	}`
+	otoResponse = `{
+		"orderListId": 13551,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "JDuOrsu0Ge8GTyvx8J7VTD",
+		"transactionTime": 1725521998054,
+		"symbol": "BTCUSDT",
+		"orders": [
+			{"symbol": "BTCUSDT", "orderId": 29, "clientOrderId": "y8RB6tQEMuHUXybqbtzTxk"},
+			{"symbol": "BTCUSDT", "orderId": 30, "clientOrderId": "xxsJfZv7Hz0ysRhYz1ZcLB"}
+		],
+		"orderReports": [
+			{
+				"symbol": "BTCUSDT", "orderId": 29, "orderListId": 13551, "clientOrderId": "y8RB6tQEMuHUXybqbtzTxk",
+				"transactTime": 1725521998054, "price": "10000.00000000", "origQty": "0.00010000",
+				"executedQty": "0.00000000", "cummulativeQuoteQty": "0.00000000", "status": "NEW",
+				"timeInForce": "GTC", "type": "LIMIT", "side": "BUY"
+			},
+			{
+				"symbol": "BTCUSDT", "orderId": 30, "orderListId": 13551, "clientOrderId": "xxsJfZv7Hz0ysRhYz1ZcLB",
+				"transactTime": 1725521998054, "price": "0.00000000", "origQty": "0.00010000",
+				"executedQty": "0.00000000", "cummulativeQuoteQty": "0.00000000", "status": "PENDING_NEW",
+				"timeInForce": "GTC", "type": "MARKET", "side": "SELL"
+			}
+		]
+	}`
+)
+
+func TestPlaceOrderList(t *testing.T) {
+	tests := []struct {
+		name        string
+		reply       string
+		place       func(c *Client) (*OrderList, error)
+		path        string
+		params      url.Values
+		contingency ContingencyType
+		orderIDs    []int64
+		types       []OrderType
+	}{
+		{
+			name:  "OCO",
+			reply: ocoResponse,
+			place: func(c *Client) (*OrderList, error) {
+				return c.PlaceOCO(&OCORequest{
+					Symbol:   "LTCBTC",
+					Side:     SellOrder,
+					Quantity: 5,
+					Above:    ListOrder{Type: LimitMakerOrder, Price: 3},
+					Below:    ListOrder{Type: StopLossLimitOrder, Price: 1, StopPrice: 1, TimeInForce: GoodTillCanceled},
+				})
+			},
+			path: addrOrderListOCO,
+			params: url.Values{
+				"symbol": {"LTCBTC"}, "side": {"SELL"}, "quantity": {"5"},
+				"aboveType": {"LIMIT_MAKER"}, "abovePrice": {"3"},
+				"belowType": {"STOP_LOSS_LIMIT"}, "belowPrice": {"1"}, "belowStopPrice": {"1"}, "belowTimeInForce": {"GTC"},
+			},
+			contingency: ContingencyOCO,
+			orderIDs:    []int64{10, 11},
+			types:       []OrderType{StopLossLimitOrder, LimitMakerOrder},
+		},
+		{
+			name:  "OTO",
+			reply: otoResponse,
+			place: func(c *Client) (*OrderList, error) {
+				return c.PlaceOTO(&OTORequest{
+					Symbol:  "BTCUSDT",
+					Working: ListOrder{Type: LimitOrder, Side: BuyOrder, Quantity: 0.0001, Price: 10000, TimeInForce: GoodTillCanceled},
+					Pending: ListOrder{Type: MarketOrder, Side: SellOrder, Quantity: 0.0001},
+				})
+			},
+			path: addrOrderListOTO,
+			params: url.Values{
+				"symbol":      {"BTCUSDT"},
+				"workingType": {"LIMIT"}, "workingSide": {"BUY"}, "workingQuantity": {"0.0001"}, "workingPrice": {"10000"},
+				"pendingType": {"MARKET"}, "pendingSide": {"SELL"}, "pendingQuantity": {"0.0001"},
+			},
+			contingency: ContingencyOTO,
+			orderIDs:    []int64{29, 30},
+			types:       []OrderType{LimitOrder, MarketOrder},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, requests := recordingServer(tt.reply)
+			defer srv.Close()
+			list, err := tt.place(NewClient(WithBaseURL(srv.URL), WithCredentials("key", "secret")))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := <-requests
+			if r.path != tt.path {
+				t.Errorf("got path %s, want %s", r.path, tt.path)
+			}
+			sent, err := url.ParseQuery(r.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for k := range tt.params {
+				if sent.Get(k) != tt.params.Get(k) {
+					t.Errorf("got %s=%q, want %q", k, sent.Get(k), tt.params.Get(k))
+				}
+			}
+			if list.ContingencyType != tt.contingency || list.ListStatusType != ListStatusExecStarted ||
+				list.ListOrderStatus != ListOrderStatusExecuting {
+				t.Errorf("got list %s %s %s", list.ContingencyType, list.ListStatusType, list.ListOrderStatus)
+			}
+			var ids []int64
+			for _, o := range list.Orders {
+				ids = append(ids, o.OrderID)
+			}
+			var types []OrderType
+			for _, o := range list.OrderReports {
+				types = append(types, o.Type)
+			}
+			if !reflect.DeepEqual(ids, tt.orderIDs) || !reflect.DeepEqual(types, tt.types) {
+				t.Errorf("got orders %v of types %v, want %v of types %v", ids, types, tt.orderIDs, tt.types)
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Response headers reporting rate limit usage, suffixed with interval, e.g. X-MBX-USED-WEIGHT-1M
+const (
+	headerUsedWeightPrefix = "X-MBX-USED-WEIGHT-"
+	headerOrderCountPrefix = "X-MBX-ORDER-COUNT-"
+)
+
+// RateLimitUsage represents usage of a rate limit as reported by Binance response headers
+type RateLimitUsage struct {
+	RateLimitType RateLimiterType
+	Interval      RateLimitInterval
+	IntervalNum   int
+	Count         int
+
+	// Limit is zero until limits are known from GetExchangeInfo or SetRateLimits
+	Limit      int
+	UpdateTime time.Time
+}
+
+// Ratio returns used fraction of the limit or zero when the limit is unknown
+func (u RateLimitUsage) Ratio() float64 {
+	if u.Limit == 0 {
+		return 0
+	}
+	return float64(u.Count) / float64(u.Limit)
+}
+
+type rateLimitKey struct {
+	limitType   RateLimiterType
+	interval    RateLimitInterval
+	intervalNum int
+}
+
+type usageThreshold struct {
+	ratio float64
+	fn    func(RateLimitUsage)
+}
+
+// usageTracker records rate limit usage reported after every request
+type usageTracker struct {
+	limiter    *rateLimiter
+	mu         sync.Mutex
+	usage      map[rateLimitKey]RateLimitUsage
+	limits     map[rateLimitKey]int
+	thresholds []usageThreshold
+}
+
+func newUsageTracker() *usageTracker {
+	return &usageTracker{
+		usage:  make(map[rateLimitKey]RateLimitUsage),
+		limits: make(map[rateLimitKey]int),
+	}
+}
+
+// parseIntervalSuffix parses header interval suffix, e.g. "1M" or "10S"
+func parseIntervalSuffix(s string) (RateLimitInterval, int, bool) {
+	if len(s) < 2 {
+		return "", 0, false
+	}
+	num, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil {
+		return "", 0, false
+	}
+	switch s[len(s)-1] {
+	case 'S':
+		return SecondRLInterval, num, true
+	case 'M':
+		return MinuteRLInterval, num, true
+	case 'H':
+		return HourRLInterval, num, true
+	case 'D':
+		return DayRLInterval, num, true
+	}
+	return "", 0, false
+}
+
+func (t *usageTracker) setLimits(limits []RateLimit) {
+	if t.limiter != nil {
+		t.limiter.setLimits(limits)
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, l := range limits {
+		key := rateLimitKey{l.RateLimitType, l.Interval, l.IntervalNum}
+		t.limits[key] = l.Limit
+		if u, ok := t.usage[key]; ok {
+			u.Limit = l.Limit
+			t.usage[key] = u
+		}
+	}
+}
+
+func (t *usageTracker) update(h http.Header, now time.Time) {
+	var reported []RateLimitUsage
+	var crossed []func()
+	t.mu.Lock()
+	for name, values := range h {
+		name = strings.ToUpper(name)
+		var limitType RateLimiterType
+		var suffix string
+		switch {
+		case strings.HasPrefix(name, headerUsedWeightPrefix):
+			limitType, suffix = RequestWeightRLType, name[len(headerUsedWeightPrefix):]
+		case strings.HasPrefix(name, headerOrderCountPrefix):
+			limitType, suffix = OrdersRLType, name[len(headerOrderCountPrefix):]
+		default:
+			continue
+		}
+		interval, num, ok := parseIntervalSuffix(suffix)
+		if !ok || len(values) == 0 {
+			continue
+		}
+		count, err := strconv.Atoi(values[0])
+		if err != nil {
+			continue
+		}
+		key := rateLimitKey{limitType, interval, num}
+		prev := t.usage[key]
+		u := RateLimitUsage{
+			RateLimitType: limitType,
+			Interval:      interval,
+			IntervalNum:   num,
+			Count:         count,
+			Limit:         t.limits[key],
+			UpdateTime:    now,
+		}
+		t.usage[key] = u
+		reported = append(reported, u)
+		for _, th := range t.thresholds {
+			if u.Ratio() >= th.ratio && prev.Ratio() < th.ratio {
+				fn := th.fn
+				crossed = append(crossed, func() { fn(u) })
+			}
+		}
+	}
+	t.mu.Unlock()
+
+	if t.limiter != nil {
+		for _, u := range reported {
+			t.limiter.observe(u)
+		}
+	}
+	for _, fn := range crossed {
+		fn()
+	}
+}
+
+func (t *usageTracker) snapshot() []RateLimitUsage {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	list := make([]RateLimitUsage, 0, len(t.usage))
+	for _, u := range t.usage {
+		list = append(list, u)
+	}
+	return list
+}
+
+// SetRateLimits sets limits used to compute rate limit usage ratios and enforced
+// by the client rate limiter. GetExchangeInfo sets them from the exchange rate limits.
+func (c *Client) SetRateLimits(limits []RateLimit) {
+	c.usage.setLimits(limits)
+}
+
+// RateLimitUsage returns usage of every rate limit reported by Binance so far
+func (c *Client) RateLimitUsage() []RateLimitUsage {
+	return c.usage.snapshot()
+}
+
+// OnRateLimitThreshold registers fn to be called when usage of a rate limit
+// crosses ratio of its limit, e.g. 0.8 for 80%. Usage is compared against
+// limits set by GetExchangeInfo or SetRateLimits. fn is called synchronously
+// after the response that reported the usage, so it must not block.
+func (c *Client) OnRateLimitThreshold(ratio float64, fn func(RateLimitUsage)) {
+	c.usage.mu.Lock()
+	defer c.usage.mu.Unlock()
+	c.usage.thresholds = append(c.usage.thresholds, usageThreshold{ratio, fn})
+}
+
+// RateLimitMode defines what the client rate limiter does when a request would exceed a limit
+type RateLimitMode int
+
+const (
+	// RateLimitWait blocks the request until the limit window resets
+	RateLimitWait RateLimitMode = iota + 1
+
+	// RateLimitFailFast fails the request with ErrRateLimitExceeded
+	RateLimitFailFast
+)
+
+// ErrRateLimitExceeded is returned by fail-fast rate limiter when a request would exceed a limit
+var ErrRateLimitExceeded = errors.New("binance: request would exceed rate limit")
+
+// rateWindow counts usage of a rate limit in fixed windows aligned to the interval
+type rateWindow struct {
+	limit  RateLimit
+	length time.Duration
+	start  time.Time
+	used   int
+}
+
+func (w *rateWindow) advance(now time.Time) {
+	if start := now.Truncate(w.length); !start.Equal(w.start) {
+		w.start = start
+		w.used = 0
+	}
+}
+
+func (w *rateWindow) cost(weight int, order bool) int {
+	switch w.limit.RateLimitType {
+	case RequestWeightRLType, RequestsRLType:
+		return weight
+	case RawRequestsRLType:
+		return 1
+	case OrdersRLType:
+		if order {
+			return 1
+		}
+	}
+	return 0
+}
+
+func intervalLength(interval RateLimitInterval, num int) time.Duration {
+	if num == 0 {
+		num = 1
+	}
+	var d time.Duration
+	switch interval {
+	case SecondRLInterval:
+		d = time.Second
+	case MinuteRLInterval:
+		d = time.Minute
+	case HourRLInterval:
+		d = time.Hour
+	case DayRLInterval:
+		d = 24 * time.Hour
+	}
+	return d * time.Duration(num)
+}
+
+// rateLimiter budgets request weights against exchange rate limits before requests are sent.
+// It is shared by all goroutines using the same client.
+type rateLimiter struct {
+	mode    RateLimitMode
+	mu      sync.Mutex
+	windows []*rateWindow
+}
+
+func newRateLimiter(mode RateLimitMode) *rateLimiter {
+	return &rateLimiter{mode: mode}
+}
+
+func (l *rateLimiter) setLimits(limits []RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	windows := make([]*rateWindow, 0, len(limits))
+	for _, limit := range limits {
+		length := intervalLength(limit.Interval, limit.IntervalNum)
+		if length == 0 || limit.Limit <= 0 {
+			continue
+		}
+		w := &rateWindow{limit: limit, length: length}
+		// Keep usage counted so far when limits are reloaded
+		for _, old := range l.windows {
+			if old.limit.RateLimitType == limit.RateLimitType && old.length == length {
+				w.start, w.used = old.start, old.used
+			}
+		}
+		windows = append(windows, w)
+	}
+	l.windows = windows
+}
+
+// observe raises window usage to the count reported by Binance,
+// which includes requests sent by other clients from the same IP.
+func (l *rateLimiter) observe(u RateLimitUsage) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, w := range l.windows {
+		if w.limit.RateLimitType != u.RateLimitType ||
+			w.limit.Interval != u.Interval || w.limit.IntervalNum != u.IntervalNum {
+			continue
+		}
+		w.advance(u.UpdateTime)
+		if u.Count > w.used {
+			w.used = u.Count
+		}
+	}
+}
+
+// reserve books request cost in every window, returning how long to wait when it does not fit
+func (l *rateLimiter) reserve(weight int, order bool, now time.Time) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var wait time.Duration
+	for _, w := range l.windows {
+		w.advance(now)
+		cost := w.cost(weight, order)
+		if cost > w.limit.Limit {
+			return 0, ErrRateLimitExceeded
+		}
+		if w.used+cost > w.limit.Limit {
+			if d := w.start.Add(w.length).Sub(now); d > wait {
+				wait = d
+			}
+		}
+	}
+	if wait > 0 {
+		return wait, nil
+	}
+	for _, w := range l.windows {
+		w.used += w.cost(weight, order)
+	}
+	return 0, nil
+}
+
+func (l *rateLimiter) wait(ctx context.Context, r *request) error {
+	weight, order := requestWeight(r), isOrderRequest(r)
+	for {
+		wait, err := l.reserve(weight, order, time.Now())
+		if err != nil || wait == 0 {
+			return err
+		}
+		if l.mode == RateLimitFailFast {
+			return ErrRateLimitExceeded
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+var testLimits = []RateLimit{
+	{RateLimitType: RequestWeightRLType, Interval: MinuteRLInterval, IntervalNum: 1, Limit: 100},
+	{RateLimitType: OrdersRLType, Interval: SecondRLInterval, IntervalNum: 10, Limit: 2},
+}
+
+type reservation struct {
+	weight int
+	order  bool
+	at     time.Duration
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		reserved []reservation
+		next     reservation
+		wait     time.Duration
+		err      error
+	}{
+		{
+			name: "fits",
+			reserved: []reservation{
+				{weight: 60},
+			},
+			next: reservation{weight: 40, at: 10 * time.Second},
+		},
+		{
+			name: "waits for window end",
+			reserved: []reservation{
+				{weight: 60},
+			},
+			next: reservation{weight: 41, at: 15 * time.Second},
+			wait: 45 * time.Second,
+		},
+		{
+			name: "window rollover",
+			reserved: []reservation{
+				{weight: 100, at: 59 * time.Second},
+			},
+			next: reservation{weight: 100, at: time.Minute},
+		},
+		{
+			name: "order count",
+			reserved: []reservation{
+				{weight: 1, order: true},
+				{weight: 1, order: true, at: time.Second},
+			},
+			next: reservation{weight: 1, order: true, at: 4 * time.Second},
+			wait: 6 * time.Second,
+		},
+		{
+			name: "order count ignores other requests",
+			reserved: []reservation{
+				{weight: 1, order: true},
+				{weight: 1, order: true},
+			},
+			next: reservation{weight: 1},
+		},
+		{
+			name: "longest wait",
+			reserved: []reservation{
+				{weight: 50, order: true},
+				{weight: 50, order: true},
+			},
+			next: reservation{weight: 1, order: true, at: 5 * time.Second},
+			wait: 55 * time.Second,
+		},
+		{
+			name: "weight above limit",
+			next: reservation{weight: 101},
+			err:  ErrRateLimitExceeded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newRateLimiter(RateLimitWait)
+			l.setLimits(testLimits)
+			for _, r := range tt.reserved {
+				if wait, err := l.reserve(r.weight, r.order, start.Add(r.at)); wait != 0 || err != nil {
+					t.Fatalf("reserve(%d) = %v, %v; want 0, nil", r.weight, wait, err)
+				}
+			}
+			wait, err := l.reserve(tt.next.weight, tt.next.order, start.Add(tt.next.at))
+			if wait != tt.wait || err != tt.err {
+				t.Errorf("reserve(%d) = %v, %v; want %v, %v", tt.next.weight, wait, err, tt.wait, tt.err)
+			}
+		})
+	}
+}
+
+func TestRateLimiterReserveKeepsUsageOnWait(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter(RateLimitWait)
+	l.setLimits(testLimits)
+	l.reserve(90, false, now)
+	if wait, _ := l.reserve(20, false, now); wait == 0 {
+		t.Fatal("reserve(20) did not wait")
+	}
+	if wait, err := l.reserve(10, false, now); wait != 0 || err != nil {
+		t.Errorf("reserve(10) = %v, %v; want 0, nil", wait, err)
+	}
+}
+
+func TestRateLimiterObserve(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+	tests := []struct {
+		name  string
+		usage RateLimitUsage
+		wait  time.Duration
+	}{
+		{
+			name:  "raises usage",
+			usage: RateLimitUsage{RateLimitType: RequestWeightRLType, Interval: MinuteRLInterval, IntervalNum: 1, Count: 95, UpdateTime: now},
+			wait:  30 * time.Second,
+		},
+		{
+			name:  "lower count keeps usage",
+			usage: RateLimitUsage{RateLimitType: RequestWeightRLType, Interval: MinuteRLInterval, IntervalNum: 1, Count: 5, UpdateTime: now},
+		},
+		{
+			name:  "other interval",
+			usage: RateLimitUsage{RateLimitType: RequestWeightRLType, Interval: MinuteRLInterval, IntervalNum: 5, Count: 95, UpdateTime: now},
+		},
+		{
+			name:  "previous window",
+			usage: RateLimitUsage{RateLimitType: RequestWeightRLType, Interval: MinuteRLInterval, IntervalNum: 1, Count: 95, UpdateTime: now.Add(-time.Minute)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := newRateLimiter(RateLimitWait)
+			l.setLimits(testLimits)
+			l.reserve(10, false, now)
+			l.observe(tt.usage)
+			wait, err := l.reserve(10, false, now)
+			if wait != tt.wait || err != nil {
+				t.Errorf("reserve(10) = %v, %v; want %v, nil", wait, err, tt.wait)
+			}
+		})
+	}
+}
+
+func TestRateLimiterFailFast(t *testing.T) {
+	l := newRateLimiter(RateLimitFailFast)
+	l.setLimits([]RateLimit{
+		{RateLimitType: RequestWeightRLType, Interval: DayRLInterval, IntervalNum: 1, Limit: 30},
+	})
+	r := &request{method: http.MethodGet, path: addrExchangeInfo}
+	if err := l.wait(context.Background(), r); err != nil {
+		t.Fatalf("first wait: %v", err)
+	}
+	if err := l.wait(context.Background(), r); err != ErrRateLimitExceeded {
+		t.Errorf("second wait: got %v, want %v", err, ErrRateLimitExceeded)
+	}
+}
@@ -0,0 +1,178 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// defaultBanCooldown is used when a 418 response does not tell when the ban expires
+const defaultBanCooldown = 2 * time.Minute
+
+// RetryPolicy configures retries of failed requests.
+//
+// 429 responses are retried after the Retry-After delay. 5xx responses and network
+// errors are retried with exponential backoff and jitter, except for order placement,
+// which may have been executed even though it failed. Binance rejects a duplicate
+// client order ID only while the first order is open, so it does not make resending safe.
+// A 418 response is never retried and puts the client into cooldown until the IP ban
+// expires; requests made during cooldown fail with *CooldownError without being sent.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries of a single request
+	MaxRetries int
+
+	// BaseDelay is the backoff before the first retry, doubled with every next retry
+	BaseDelay time.Duration
+
+	// MaxDelay caps the backoff
+	MaxDelay time.Duration
+}
+
+// CooldownError is returned for requests made while the client is in cooldown
+// after Binance banned its IP
+type CooldownError struct {
+	Until time.Time
+}
+
+func (e *CooldownError) Error() string {
+	return fmt.Sprintf("binance: IP banned, requests are suspended until %s", e.Until.Format(time.RFC3339))
+}
+
+// WithRetry enables retries of failed requests as described by RetryPolicy
+func WithRetry(p RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retry = &retrier{policy: p}
+	}
+}
+
+// retrier retries requests and keeps the client cooldown state
+type retrier struct {
+	policy RetryPolicy
+
+	mu            sync.Mutex
+	cooldownUntil time.Time
+}
+
+var bannedUntilRegexp = regexp.MustCompile(`banned until (\d+)`)
+
+// retryAfter returns delay from Retry-After header
+func retryAfter(e *APIError) (time.Duration, bool) {
+	if e.Header == nil {
+		return 0, false
+	}
+	sec, err := strconv.Atoi(e.Header.Get("Retry-After"))
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(sec) * time.Second, true
+}
+
+// banExpiry returns when IP ban reported by e expires
+func banExpiry(e *APIError, now time.Time) time.Time {
+	if d, ok := retryAfter(e); ok {
+		return now.Add(d)
+	}
+	if m := bannedUntilRegexp.FindStringSubmatch(e.Message); m != nil {
+		if ms, err := strconv.ParseInt(m[1], 10, 64); err == nil {
+			return time.Unix(0, ms*int64(time.Millisecond))
+		}
+	}
+	return now.Add(defaultBanCooldown)
+}
+
+// retrySafe reports whether r can be sent again after it may have reached the exchange.
+// Order placement is never safe: when the first order was filled or canceled already,
+// Binance accepts a second order with the same client order ID.
+func retrySafe(r *request) bool {
+	return !isOrderRequest(r)
+}
+
+func (rt *retrier) backoff(attempt int) time.Duration {
+	return backoffDelay(rt.policy.BaseDelay, rt.policy.MaxDelay, attempt)
+}
+
+// backoffDelay returns exponential backoff of attempt, starting with base and capped by max
+func backoffDelay(base, max time.Duration, attempt int) time.Duration {
+	d := base << uint(attempt)
+	if d <= 0 || max > 0 && d > max {
+		d = max
+	}
+	if d <= 0 {
+		return 0
+	}
+	// Full jitter spreads retries of clients that failed at the same time
+	return time.Duration(rand.Int63n(int64(d)))
+}
+
+// delay returns how long to wait before retrying failed attempt, or false when err is final
+func (rt *retrier) delay(r *request, err error, attempt int) (time.Duration, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch {
+		case apiErr.HTTPStatus == http.StatusTeapot:
+			rt.startCooldown(banExpiry(apiErr, time.Now()))
+			return 0, false
+		case apiErr.HTTPStatus == http.StatusTooManyRequests:
+			// Rejected requests were not executed, so they are always safe to retry
+			if d, ok := retryAfter(apiErr); ok {
+				return d, true
+			}
+			return rt.backoff(attempt), true
+		case apiErr.HTTPStatus >= 500:
+			return rt.backoff(attempt), retrySafe(r)
+		}
+		return 0, false
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return rt.backoff(attempt), retrySafe(r)
+	}
+	return 0, false
+}
+
+func (rt *retrier) startCooldown(until time.Time) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if until.After(rt.cooldownUntil) {
+		rt.cooldownUntil = until
+	}
+}
+
+func (rt *retrier) cooldown() error {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	if time.Now().Before(rt.cooldownUntil) {
+		return &CooldownError{Until: rt.cooldownUntil}
+	}
+	return nil
+}
+
+func (rt *retrier) do(ctx context.Context, r *request, send func() error) error {
+	for attempt := 0; ; attempt++ {
+		if err := rt.cooldown(); err != nil {
+			return err
+		}
+		err := send()
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+		d, retry := rt.delay(r, err, attempt)
+		if !retry || attempt >= rt.policy.MaxRetries {
+			return err
+		}
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
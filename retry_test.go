@@ -0,0 +1,135 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// failingServer responds with status and header to the first failures requests and with reply afterwards
+func failingServer(failures int32, status int, header map[string]string, reply string) (*httptest.Server, *int32) {
+	var count int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) <= failures {
+			for k, v := range header {
+				w.Header().Set(k, v)
+			}
+			w.WriteHeader(status)
+			w.Write([]byte(`{"code":-1003,"msg":"failed"}`))
+			return
+		}
+		w.Write([]byte(reply))
+	}))
+	return srv, &count
+}
+
+func TestRetryTooManyRequests(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     map[string]string
+		baseDelay  time.Duration
+		minElapsed time.Duration
+	}{
+		{
+			name:      "backoff",
+			baseDelay: time.Millisecond,
+		},
+		{
+			name:       "retry after",
+			header:     map[string]string{"Retry-After": "1"},
+			baseDelay:  time.Hour,
+			minElapsed: time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, count := failingServer(2, http.StatusTooManyRequests, tt.header, `{}`)
+			defer srv.Close()
+			c := NewClient(WithBaseURL(srv.URL), WithRetry(RetryPolicy{MaxRetries: 2, BaseDelay: tt.baseDelay, MaxDelay: tt.baseDelay}))
+			start := time.Now()
+			if err := c.Ping(); err != nil {
+				t.Fatalf("Ping: %v", err)
+			}
+			if elapsed := time.Since(start); elapsed < 2*tt.minElapsed {
+				t.Errorf("retried after %v, want at least %v", elapsed, 2*tt.minElapsed)
+			}
+			if n := atomic.LoadInt32(count); n != 3 {
+				t.Errorf("sent %d requests, want 3", n)
+			}
+		})
+	}
+}
+
+func TestRetryMaxRetries(t *testing.T) {
+	srv, count := failingServer(10, http.StatusTooManyRequests, nil, `{}`)
+	defer srv.Close()
+	c := NewClient(WithBaseURL(srv.URL), WithRetry(RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond}))
+	var apiErr *APIError
+	if err := c.Ping(); !errors.As(err, &apiErr) || apiErr.HTTPStatus != http.StatusTooManyRequests {
+		t.Fatalf("Ping: got %v, want 429 error", err)
+	}
+	if n := atomic.LoadInt32(count); n != 3 {
+		t.Errorf("sent %d requests, want 3", n)
+	}
+}
+
+func TestRetryBanCooldown(t *testing.T) {
+	srv, count := failingServer(1, http.StatusTeapot, map[string]string{"Retry-After": "60"}, `{}`)
+	defer srv.Close()
+	c := NewClient(WithBaseURL(srv.URL), WithRetry(RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond}))
+	var apiErr *APIError
+	if err := c.Ping(); !errors.As(err, &apiErr) || apiErr.HTTPStatus != http.StatusTeapot {
+		t.Fatalf("first Ping: got %v, want 418 error", err)
+	}
+	var cooldown *CooldownError
+	if err := c.Ping(); !errors.As(err, &cooldown) {
+		t.Fatalf("second Ping: got %v, want *CooldownError", err)
+	}
+	if d := time.Until(cooldown.Until); d < 55*time.Second || d > time.Minute {
+		t.Errorf("cooldown ends in %v, want about a minute", d)
+	}
+	if n := atomic.LoadInt32(count); n != 1 {
+		t.Errorf("sent %d requests, want 1", n)
+	}
+}
+
+func TestRetryServerErrorOnOrder(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		clientOrderID string
+		requests      int32
+		fails         bool
+	}{
+		{name: "without client order ID", status: http.StatusServiceUnavailable, requests: 1, fails: true},
+		{name: "with client order ID", status: http.StatusServiceUnavailable, clientOrderID: "order-1", requests: 1, fails: true},
+		{name: "bad gateway", status: http.StatusBadGateway, clientOrderID: "order-1", requests: 1, fails: true},
+		{name: "too many requests", status: http.StatusTooManyRequests, clientOrderID: "order-1", requests: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, count := failingServer(1, tt.status, nil, `{}`)
+			defer srv.Close()
+			c := NewClient(WithBaseURL(srv.URL), WithCredentials("key", "secret"),
+				WithRetry(RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond}))
+			_, err := c.PlaceOrder(&OrderRequest{
+				Symbol:           "BNBBTC",
+				Side:             BuyOrder,
+				Type:             LimitOrder,
+				TimeInForce:      GoodTillCanceled,
+				Quantity:         1,
+				Price:            0.01,
+				NewClientOrderID: tt.clientOrderID,
+			})
+			if (err != nil) != tt.fails {
+				t.Errorf("PlaceOrder: got %v, want failure %v", err, tt.fails)
+			}
+			if n := atomic.LoadInt32(count); n != tt.requests {
+				t.Errorf("sent %d requests, want %d", n, tt.requests)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package binance
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"net/url"
+)
+
+// Signer signs the payload of requests to SIGNED endpoints.
+// The returned signature is sent as the "signature" parameter.
+type Signer interface {
+	Sign(payload []byte) (string, error)
+}
+
+// HMACSigner signs payloads with HMAC-SHA256 using the API secret key
+type HMACSigner struct {
+	secret []byte
+}
+
+// NewHMACSigner creates HMAC-SHA256 signer from API secret key
+func NewHMACSigner(secretKey string) *HMACSigner {
+	return &HMACSigner{secret: []byte(secretKey)}
+}
+
+// Sign returns hex encoded HMAC-SHA256 signature of payload
+func (s *HMACSigner) Sign(payload []byte) (string, error) {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// RSASigner signs payloads with RSASSA-PKCS1-v1_5 over SHA-256
+type RSASigner struct {
+	key *rsa.PrivateKey
+}
+
+// NewRSASigner creates RSA signer from private key
+func NewRSASigner(key *rsa.PrivateKey) *RSASigner {
+	return &RSASigner{key: key}
+}
+
+// LoadRSASigner creates RSA signer from PEM encoded PKCS#8 or PKCS#1 private key
+func LoadRSASigner(pemBytes []byte) (*RSASigner, error) {
+	block, err := decodePEM(pemBytes)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return NewRSASigner(key), nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("binance: private key is not an RSA key")
+	}
+	return NewRSASigner(rsaKey), nil
+}
+
+// LoadRSASignerFile creates RSA signer from PEM file
+func LoadRSASignerFile(path string) (*RSASigner, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LoadRSASigner(b)
+}
+
+// Sign returns base64 encoded RSA signature of payload
+func (s *RSASigner) Sign(payload []byte) (string, error) {
+	sum := sha256.Sum256(payload)
+	sig, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, sum[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// Ed25519Signer signs payloads with Ed25519.
+// Ed25519 keys are the only keys accepted by websocket API session logon.
+type Ed25519Signer struct {
+	key ed25519.PrivateKey
+}
+
+// NewEd25519Signer creates Ed25519 signer from private key
+func NewEd25519Signer(key ed25519.PrivateKey) *Ed25519Signer {
+	return &Ed25519Signer{key: key}
+}
+
+// LoadEd25519Signer creates Ed25519 signer from PEM encoded PKCS#8 private key
+func LoadEd25519Signer(pemBytes []byte) (*Ed25519Signer, error) {
+	block, err := decodePEM(pemBytes)
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, errors.New("binance: private key is not an Ed25519 key")
+	}
+	return NewEd25519Signer(edKey), nil
+}
+
+// LoadEd25519SignerFile creates Ed25519 signer from PEM file
+func LoadEd25519SignerFile(path string) (*Ed25519Signer, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LoadEd25519Signer(b)
+}
+
+// Sign returns base64 encoded Ed25519 signature of payload
+func (s *Ed25519Signer) Sign(payload []byte) (string, error) {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(s.key, payload)), nil
+}
+
+// signParams encodes p and appends its signature
+func signParams(s Signer, p params) (string, error) {
+	query := encodeParams(p)
+	sig, err := s.Sign([]byte(query))
+	if err != nil {
+		return "", err
+	}
+	return query + "&signature=" + url.QueryEscape(sig), nil
+}
+
+func decodePEM(pemBytes []byte) (*pem.Block, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("binance: no PEM data found")
+	}
+	return block, nil
+}
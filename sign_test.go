@@ -0,0 +1,240 @@
+package binance
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHMACSignerExample(t *testing.T) {
+	// Example from Binance API documentation of SIGNED endpoints
+	signer := NewHMACSigner("NhqPtmdSJYdKjVHjA7PZj4Mge3R5YNiP1e3UZjInClVN65XAbvqqM6A7H5fATj0j")
+	payload := "symbol=LTCBTC&side=BUY&type=LIMIT&timeInForce=GTC&quantity=1&price=0.1&recvWindow=5000&timestamp=1499827319559"
+	sig, err := signer.Sign([]byte(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "c8db56825ae71d6d79447849e617115f4a920fa2acdcab2b053c4b2838bd6b71"; sig != want {
+		t.Errorf("got signature %s, want %s", sig, want)
+	}
+}
+
+type recordedRequest struct {
+	method string
+	path   string
+	query  string
+	body   string
+	header http.Header
+}
+
+// recordingServer records requests and replies to them with reply
+func recordingServer(reply string) (*httptest.Server, <-chan recordedRequest) {
+	requests := make(chan recordedRequest, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests <- recordedRequest{r.Method, r.URL.Path, r.URL.RawQuery, string(body), r.Header}
+		w.Write([]byte(reply))
+	}))
+	return srv, requests
+}
+
+func TestSignedRequests(t *testing.T) {
+	const secret = "secret"
+	srv, requests := recordingServer(`{}`)
+	defer srv.Close()
+	c := NewClient(WithBaseURL(srv.URL), WithCredentials("api-key", secret), WithRecvWindow(5*time.Second))
+
+	tests := []struct {
+		name   string
+		call   func() error
+		method string
+		inBody bool
+		params url.Values
+	}{
+		{
+			name: "POST in body",
+			call: func() error {
+				_, err := c.PlaceOrder(&OrderRequest{Symbol: "LTCBTC", Side: BuyOrder, Type: MarketOrder, Quantity: 1})
+				return err
+			},
+			method: http.MethodPost,
+			inBody: true,
+			params: url.Values{"symbol": {"LTCBTC"}, "side": {"BUY"}, "type": {"MARKET"}, "quantity": {"1"}},
+		},
+		{
+			name: "GET in query",
+			call: func() error {
+				_, err := c.GetAccount()
+				return err
+			},
+			method: http.MethodGet,
+		},
+		{
+			name: "DELETE in query",
+			call: func() error {
+				_, err := c.CancelOrder(&OrderQuery{Symbol: "LTCBTC", OrderID: 42})
+				return err
+			},
+			method: http.MethodDelete,
+			params: url.Values{"symbol": {"LTCBTC"}, "orderId": {"42"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatal(err)
+			}
+			r := <-requests
+			if r.method != tt.method {
+				t.Errorf("got method %s, want %s", r.method, tt.method)
+			}
+			if key := r.header.Get(headerAPIKey); key != "api-key" {
+				t.Errorf("got API key header %q", key)
+			}
+			payload, other := r.query, r.body
+			if tt.inBody {
+				payload, other = r.body, r.query
+				if ct := r.header.Get("Content-Type"); ct != "application/x-www-form-urlencoded" {
+					t.Errorf("got content type %q", ct)
+				}
+			}
+			if other != "" {
+				t.Errorf("got unexpected parameters %q", other)
+			}
+			i := strings.LastIndex(payload, "&signature=")
+			if i < 0 {
+				t.Fatalf("payload %q is not signed", payload)
+			}
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write([]byte(payload[:i]))
+			if sig := payload[i+len("&signature="):]; sig != hex.EncodeToString(mac.Sum(nil)) {
+				t.Errorf("signature %s does not match payload %q", sig, payload[:i])
+			}
+			values, err := url.ParseQuery(payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if values.Get("recvWindow") != "5000" {
+				t.Errorf("got recvWindow %q, want 5000", values.Get("recvWindow"))
+			}
+			ms, err := strconv.ParseInt(values.Get("timestamp"), 10, 64)
+			if err != nil || time.Since(msTime(ms)) > time.Minute {
+				t.Errorf("got timestamp %q", values.Get("timestamp"))
+			}
+			for k := range tt.params {
+				if values.Get(k) != tt.params.Get(k) {
+					t.Errorf("got %s=%q, want %q", k, values.Get(k), tt.params.Get(k))
+				}
+			}
+		})
+	}
+}
+
+func TestPublicRequestWithoutCredentials(t *testing.T) {
+	srv, requests := recordingServer(`[]`)
+	defer srv.Close()
+	c := NewClient(WithBaseURL(srv.URL))
+	if _, err := c.GetAccount(); err != ErrMissingCredentials {
+		t.Errorf("GetAccount: got %v, want %v", err, ErrMissingCredentials)
+	}
+	if _, err := c.QueryTrades(&TradesRequest{Symbol: "LTCBTC", Limit: 5}); err != nil {
+		t.Fatal(err)
+	}
+	r := <-requests
+	if r.query != "limit=5&symbol=LTCBTC" || r.header.Get(headerAPIKey) != "" {
+		t.Errorf("got query %q and API key %q", r.query, r.header.Get(headerAPIKey))
+	}
+}
+
+func TestRSASigner(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signers := map[string][]byte{
+		"PKCS#1": pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+		"PKCS#8": pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}),
+	}
+	payload := []byte("symbol=BTCUSDT&side=SELL&type=LIMIT&timeInForce=GTC&quantity=1&price=0.2&timestamp=1668481559918")
+	for name, pemBytes := range signers {
+		signer, err := LoadRSASigner(pemBytes)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		sig, err := signer.Sign(payload)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		raw, err := base64.StdEncoding.DecodeString(sig)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		sum := sha256.Sum256(payload)
+		if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, sum[:], raw); err != nil {
+			t.Errorf("%s: signature does not verify: %v", name, err)
+		}
+	}
+}
+
+func TestEd25519Signer(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := LoadEd25519Signer(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := []byte("apiKey=key&timestamp=1668481559918")
+	sig, err := signer.Sign(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ed25519.Verify(pub, payload, raw) {
+		t.Error("signature does not verify")
+	}
+}
+
+func TestLoadSignerKeyType(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(edKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadRSASigner(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})); err == nil {
+		t.Error("LoadRSASigner accepted Ed25519 key")
+	}
+	if _, err := LoadEd25519Signer([]byte("not a key")); err == nil {
+		t.Error("LoadEd25519Signer accepted data without PEM block")
+	}
+}
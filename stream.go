@@ -1,13 +1,331 @@
 package binance
 
-import "github.com/gorilla/websocket"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// StreamState represents state of a stream connection
+type StreamState int
+
+// Stream states
+const (
+	StreamConnected StreamState = iota + 1
+	StreamReconnecting
+	StreamClosed
+)
+
+func (s StreamState) String() string {
+	switch s {
+	case StreamConnected:
+		return "connected"
+	case StreamReconnecting:
+		return "reconnecting"
+	case StreamClosed:
+		return "closed"
+	}
+	return fmt.Sprintf("StreamState(%d)", int(s))
+}
+
+// ReconnectPolicy configures reconnecting of streams dropped by Binance,
+// which closes every connection at least once a day.
+type ReconnectPolicy struct {
+	// MaxAttempts is the maximum number of dials after the connection drops, zero means no limit
+	MaxAttempts int
+
+	// BaseDelay is the backoff before the second dial, doubled with every next dial
+	BaseDelay time.Duration
+
+	// MaxDelay caps the backoff
+	MaxDelay time.Duration
+}
+
+// WithStreamReconnect makes streams opened by the client reconnect when their connection
+// drops. Read reports every reconnect with *ReconnectedError and continues reading
+// from the new connection with its next call.
+func WithStreamReconnect(p ReconnectPolicy) ClientOption {
+	return func(c *Client) {
+		c.reconnect = &p
+	}
+}
+
+// ReconnectedError is returned by Read after a stream reconnected.
+// Events sent while the stream was disconnected are lost.
+type ReconnectedError struct {
+	// Err is the error that dropped the connection
+	Err error
+
+	// Attempts is the number of dials it took to reconnect
+	Attempts int
+
+	// Downtime is how long the stream was disconnected
+	Downtime time.Duration
+}
+
+func (e *ReconnectedError) Error() string {
+	return fmt.Sprintf("binance: stream reconnected after %s, events may have been missed: %v", e.Downtime, e.Err)
+}
+
+// Unwrap returns the error that dropped the connection
+func (e *ReconnectedError) Unwrap() error {
+	return e.Err
+}
+
+// ResubscribeError is returned by Read when streams subscribed to a stream could not
+// be subscribed again after it reconnected. The stream continues without them.
+type ResubscribeError struct {
+	// Streams are names of the streams that are no longer subscribed
+	Streams []string
+
+	// Err is the error of the subscription request
+	Err error
+}
+
+func (e *ResubscribeError) Error() string {
+	return fmt.Sprintf("binance: resubscribing %s after reconnect failed: %v", strings.Join(e.Streams, ", "), e.Err)
+}
+
+// Unwrap returns the error of the subscription request
+func (e *ResubscribeError) Unwrap() error {
+	return e.Err
+}
+
+// streamConn is a connection to market streams which can be replaced by reconnecting
+type streamConn struct {
+	client   *Client
+	ctx      context.Context
+	combined bool
+	done     chan struct{}
+	once     sync.Once
+	limiter  *messageLimiter
+	writeMu  sync.Mutex
+	calling  chan struct{}
+
+	mu             sync.Mutex
+	socket         *websocket.Conn
+	state          StreamState
+	names          []string
+	nextID         uint64
+	pending        map[uint64]chan controlResponse
+	resubscribeErr error
+}
 
 type stream struct {
-	socket *websocket.Conn
+	*streamConn
+}
+
+// openStream dials stream with given name. Canceling ctx closes the stream,
+// which aborts any pending read.
+func (c *Client) openStream(ctx context.Context, name string) (stream, error) {
+	s, err := c.openStreamConn(ctx, []string{name}, false)
+	if err != nil {
+		return stream{}, err
+	}
+	return stream{s}, nil
+}
+
+// openStreamConn dials raw stream or, when combined is set, combined stream of names
+func (c *Client) openStreamConn(ctx context.Context, names []string, combined bool) (*streamConn, error) {
+	s := &streamConn{
+		client:   c,
+		ctx:      ctx,
+		names:    names,
+		combined: combined,
+		done:     make(chan struct{}),
+		limiter:  new(messageLimiter),
+		calling:  make(chan struct{}, 1),
+		pending:  make(map[uint64]chan controlResponse),
+	}
+	socket, err := s.dial()
+	if err != nil {
+		return nil, err
+	}
+	s.socket = socket
+	s.state = StreamConnected
+	if ctx.Done() != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+				s.mu.Lock()
+				s.socket.Close()
+				s.mu.Unlock()
+			case <-s.done:
+			}
+		}()
+	}
+	return s, nil
+}
+
+// dial connects to current streams. Raw stream connection is dialed with the first stream;
+// the rest of streams subscribed to it have to be subscribed again.
+func (s *streamConn) dial() (*websocket.Conn, error) {
+	s.mu.Lock()
+	names := s.names
+	s.mu.Unlock()
+	addr := s.client.streamBaseURL + "/ws"
+	switch {
+	case s.combined && len(names) > 0:
+		addr = s.client.streamBaseURL + "/stream?streams=" + strings.Join(names, "/")
+	case s.combined:
+		addr = s.client.streamBaseURL + "/stream"
+	case len(names) > 0:
+		addr += "/" + names[0]
+	}
+	return s.client.dialWebsocket(s.ctx, addr, s.limiter)
+}
+
+// State returns state of the stream connection
+func (s *streamConn) State() StreamState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state
+}
+
+func (s *streamConn) current() (*websocket.Conn, StreamState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := s.resubscribeErr
+	s.resubscribeErr = nil
+	return s.socket, s.state, err
+}
+
+// readMessage reads next message. Once the stream context is done
+// the context error is returned instead of the socket error.
+// Responses to subscription requests are passed to their callers.
+func (s *streamConn) readMessage() ([]byte, error) {
+	for {
+		socket, state, err := s.current()
+		if state == StreamClosed {
+			return nil, contextError(s.ctx, ErrStreamClosed)
+		}
+		if err != nil {
+			return nil, err
+		}
+		data, err := s.client.readSocket(socket)
+		if err != nil {
+			return nil, s.readFailed(err)
+		}
+		if !s.dispatchResponse(data) {
+			return data, nil
+		}
+	}
+}
+
+// readFailed reconnects after read error when reconnecting is enabled
+// and fails the connection otherwise
+func (s *streamConn) readFailed(err error) error {
+	if s.State() == StreamClosed {
+		// The socket was closed by Close while being read
+		err = ErrStreamClosed
+	} else if s.ctx.Err() == nil && s.client.reconnect != nil {
+		err = s.reconnect(err)
+		if _, ok := err.(*ReconnectedError); ok {
+			return err
+		}
+	}
+	s.mu.Lock()
+	s.state = StreamClosed
+	s.mu.Unlock()
+	s.failPending(contextError(s.ctx, err))
+	return contextError(s.ctx, err)
+}
+
+// readJSON reads next message into v
+func (s *streamConn) readJSON(v interface{}) error {
+	data, err := s.readMessage()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
 }
 
-// Close function closes underlying websocket connection
-func (s stream) Close() error {
+// reconnect dials the stream again after its connection dropped with cause
+func (s *streamConn) reconnect(cause error) error {
+	s.mu.Lock()
+	if s.state == StreamClosed {
+		s.mu.Unlock()
+		return cause
+	}
+	s.state = StreamReconnecting
+	s.mu.Unlock()
+
+	p := s.client.reconnect
+	start := time.Now()
+	err := cause
+	for attempt := 0; p.MaxAttempts == 0 || attempt < p.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(backoffDelay(p.BaseDelay, p.MaxDelay, attempt-1))
+			select {
+			case <-s.ctx.Done():
+				timer.Stop()
+				return s.ctx.Err()
+			case <-s.done:
+				timer.Stop()
+				return ErrStreamClosed
+			case <-timer.C:
+			}
+		}
+		var socket *websocket.Conn
+		if socket, err = s.dial(); err != nil {
+			continue
+		}
+		s.mu.Lock()
+		if s.state == StreamClosed || s.ctx.Err() != nil {
+			s.mu.Unlock()
+			socket.Close()
+			return contextError(s.ctx, ErrStreamClosed)
+		}
+		s.socket.Close()
+		s.socket = socket
+		s.state = StreamConnected
+		var rest []string
+		if !s.combined && len(s.names) > 1 {
+			rest = s.names[1:]
+		}
+		s.mu.Unlock()
+		s.failPending(errResubscribed)
+		if len(rest) > 0 {
+			// Response is received by the next reads, so streams are subscribed in background
+			go s.resubscribe(append([]string(nil), rest...))
+		}
+		return &ReconnectedError{Err: cause, Attempts: attempt + 1, Downtime: time.Since(start)}
+	}
+	s.mu.Lock()
+	s.state = StreamClosed
+	s.mu.Unlock()
+	return contextError(s.ctx, err)
+}
+
+// resubscribe subscribes names again after reconnect. Failure is reported by the next read
+// and the names are removed from the connection.
+func (s *streamConn) resubscribe(names []string) {
+	_, err := s.call(s.ctx, "SUBSCRIBE", names)
+	if err == nil || err == errResubscribed || err == ErrStreamClosed || s.ctx.Err() != nil {
+		// Streams are subscribed or will be by the next reconnect
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, name := range names {
+		if i := indexOf(s.names, name); i >= 0 {
+			s.names = append(s.names[:i:i], s.names[i+1:]...)
+		}
+	}
+	s.resubscribeErr = &ResubscribeError{Streams: names, Err: err}
+}
+
+// Close closes underlying websocket connection
+func (s *streamConn) Close() error {
+	s.once.Do(func() { close(s.done) })
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = StreamClosed
 	return s.socket.Close()
 }
 
@@ -17,7 +335,7 @@ type AggregateTradeStream struct {
 }
 
 func (s AggregateTradeStream) Read() (event *AggregateTradeEvent, err error) {
-	err = s.socket.ReadJSON(&event)
+	err = s.readJSON(&event)
 	return
 }
 
@@ -27,7 +345,7 @@ type TradeStream struct {
 }
 
 func (s TradeStream) Read() (event *TradeEvent, err error) {
-	err = s.socket.ReadJSON(&event)
+	err = s.readJSON(&event)
 	return
 }
 
@@ -37,7 +355,7 @@ type ChartStream struct {
 }
 
 func (s ChartStream) Read() (event ChartEvent, err error) {
-	err = s.socket.ReadJSON(&event)
+	err = s.readJSON(&event)
 	return
 }
 
@@ -47,7 +365,7 @@ type TickerStream struct {
 }
 
 func (s TickerStream) Read() (event TickerEvent, err error) {
-	err = s.socket.ReadJSON(&event)
+	err = s.readJSON(&event)
 	return
 }
 
@@ -57,7 +375,7 @@ type TickersStream struct {
 }
 
 func (s TickersStream) Read() (events []TickerEvent, err error) {
-	err = s.socket.ReadJSON(&events)
+	err = s.readJSON(&events)
 	return
 }
 
@@ -67,8 +385,22 @@ type PartialBookStream struct {
 }
 
 func (s PartialBookStream) Read() (event *OrderBook, err error) {
+	data, err := s.readMessage()
+	if err != nil {
+		return nil, err
+	}
+	if event, err = parsePartialBook(data); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	event.Symbol = streamSymbol(s.names[0])
+	s.mu.Unlock()
+	return event, nil
+}
+
+func parsePartialBook(data []byte) (*OrderBook, error) {
 	r := new(rawOrderBook)
-	if err := s.socket.ReadJSON(r); err != nil {
+	if err := json.Unmarshal(data, r); err != nil {
 		return nil, err
 	}
 	return parseOrderBook(r)
@@ -80,6 +412,14 @@ type DiffDepthStream struct {
 }
 
 func (s DiffDepthStream) Read() (event *DiffDepth, err error) {
+	data, err := s.readMessage()
+	if err != nil {
+		return nil, err
+	}
+	return parseDiffDepth(data)
+}
+
+func parseDiffDepth(data []byte) (*DiffDepth, error) {
 	var rawBook struct {
 		EventType     string          `json:"e"`
 		EventTime     uint64          `json:"E"`
@@ -89,15 +429,15 @@ func (s DiffDepthStream) Read() (event *DiffDepth, err error) {
 		Bids          [][]interface{} `json:"b"`
 		Asks          [][]interface{} `json:"a"`
 	}
-	if err = s.socket.ReadJSON(&rawBook); err != nil {
-		return
+	if err := json.Unmarshal(data, &rawBook); err != nil {
+		return nil, err
 	}
-	var bids []Order
-	var asks []Order
-	if bids, err = parseOrders(rawBook.Bids); err != nil {
+	bids, err := parseOrders(rawBook.Bids)
+	if err != nil {
 		return nil, err
 	}
-	if asks, err = parseOrders(rawBook.Asks); err != nil {
+	asks, err := parseOrders(rawBook.Asks)
+	if err != nil {
 		return nil, err
 	}
 	return &DiffDepth{
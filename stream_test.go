@@ -0,0 +1,71 @@
+package binance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestStreamReadAfterClose(t *testing.T) {
+	srv := combinedServer(0)
+	defer srv.Close()
+	c := NewClient(WithStreamBaseURL("ws" + strings.TrimPrefix(srv.URL, "http")))
+
+	trades, err := c.OpenTradeStream("BNBBTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st := trades.State(); st != StreamConnected {
+		t.Errorf("trade stream: got state %s, want %s", st, StreamConnected)
+	}
+	trades.Close()
+	if _, err := trades.Read(); err != ErrStreamClosed {
+		t.Errorf("trade stream: got %v, want %v", err, ErrStreamClosed)
+	}
+	if st := trades.State(); st != StreamClosed {
+		t.Errorf("trade stream: got state %s, want %s", st, StreamClosed)
+	}
+
+	combined := openTestCombinedStream(t, srv)
+	if st := combined.State(); st != StreamConnected {
+		t.Errorf("combined stream: got state %s, want %s", st, StreamConnected)
+	}
+	combined.Close()
+	if _, err := combined.Read(); err != ErrStreamClosed {
+		t.Errorf("combined stream: got %v, want %v", err, ErrStreamClosed)
+	}
+	if st := combined.State(); st != StreamClosed {
+		t.Errorf("combined stream: got state %s, want %s", st, StreamClosed)
+	}
+}
+
+func TestPartialBookStreamSymbol(t *testing.T) {
+	var upgrader websocket.Upgrader
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		socket, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer socket.Close()
+		socket.WriteMessage(websocket.TextMessage, []byte(`{"lastUpdateId":160,
+			"bids":[["0.0024","10"]],"asks":[["0.0026","100"]]}`))
+		socket.ReadMessage()
+	}))
+	defer srv.Close()
+	c := NewClient(WithStreamBaseURL("ws" + strings.TrimPrefix(srv.URL, "http")))
+	s, err := c.OpenPartialBookStream("BNBBTC", "5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	book, err := s.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if book.Symbol != "BNBBTC" || book.LastUpdateID != 160 || eventKey(book) != "BNBBTC" {
+		t.Errorf("got book %+v with key %q, want BNBBTC", book, eventKey(book))
+	}
+}
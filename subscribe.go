@@ -0,0 +1,234 @@
+package binance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// messageInterval paces messages sent to Binance, which allows
+// 5 messages per second per connection including pings and pongs
+const messageInterval = time.Second / 5
+
+// errResubscribed fails requests pending when the stream reconnected
+var errResubscribed = errors.New("binance: stream reconnected before response was received")
+
+// messageLimiter paces messages sent over a connection
+type messageLimiter struct {
+	mu   sync.Mutex
+	next time.Time
+}
+
+// reserve books next free slot, returning how long to wait for it
+func (l *messageLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(messageInterval)
+	return wait
+}
+
+type controlRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params,omitempty"`
+	ID     uint64   `json:"id"`
+}
+
+type controlResponse struct {
+	result json.RawMessage
+	err    error
+}
+
+// isControlResponse reports whether data is response to a subscription request
+// rather than a stream event, which are never prefixed by these keys
+func isControlResponse(data []byte) bool {
+	for _, prefix := range []string{`{"result"`, `{"id"`, `{"error"`, `{"code"`} {
+		if bytes.HasPrefix(data, []byte(prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// dispatchResponse passes data to the caller waiting for it, reporting false when data is not a response
+func (s *streamConn) dispatchResponse(data []byte) bool {
+	if !isControlResponse(data) {
+		return false
+	}
+	var res struct {
+		ID      *uint64         `json:"id"`
+		Result  json.RawMessage `json:"result"`
+		Error   *APIError       `json:"error"`
+		Code    int             `json:"code"`
+		Message string          `json:"msg"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil || res.ID == nil {
+		return false
+	}
+	reply := controlResponse{result: res.Result}
+	switch {
+	case res.Error != nil:
+		reply.err = res.Error
+	case res.Message != "":
+		reply.err = &APIError{Code: res.Code, Message: res.Message}
+	}
+	s.mu.Lock()
+	ch, ok := s.pending[*res.ID]
+	delete(s.pending, *res.ID)
+	s.mu.Unlock()
+	if ok {
+		ch <- reply
+	}
+	return true
+}
+
+// hasPending reports whether a request waits for response
+func (s *streamConn) hasPending() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.pending) > 0
+}
+
+// failPending fails all requests waiting for response
+func (s *streamConn) failPending(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, ch := range s.pending {
+		ch <- controlResponse{err: err}
+		delete(s.pending, id)
+	}
+}
+
+// call sends request with given method and waits for its response,
+// which is received by the goroutine reading the stream
+func (s *streamConn) call(ctx context.Context, method string, params []string) (json.RawMessage, error) {
+	s.mu.Lock()
+	if s.state == StreamClosed {
+		s.mu.Unlock()
+		return nil, ErrStreamClosed
+	}
+	s.nextID++
+	id := s.nextID
+	ch := make(chan controlResponse, 1)
+	s.pending[id] = ch
+	socket := s.socket
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, id)
+		s.mu.Unlock()
+	}()
+
+	timer := time.NewTimer(s.limiter.reserve(time.Now()))
+	select {
+	case <-ctx.Done():
+		timer.Stop()
+		return nil, ctx.Err()
+	case <-timer.C:
+	}
+	s.writeMu.Lock()
+	err := socket.WriteJSON(controlRequest{Method: method, Params: params, ID: id})
+	s.writeMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	// Wake the reader of a combined stream waiting to deliver an event
+	select {
+	case s.calling <- struct{}{}:
+	default:
+	}
+	select {
+	case res := <-ch:
+		return res.result, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-s.done:
+		return nil, ErrStreamClosed
+	}
+}
+
+// Subscribe subscribes the connection to more streams. Stream events are delivered
+// by Read as they are, so a typed stream should be subscribed only to streams of its type.
+// The response is received by the goroutine reading the stream, so the stream has to be
+// read while subscribing: Subscribe must not be called from the goroutine reading the
+// stream, nor from a handler of stream delivered with BackpressureBlock.
+func (s *streamConn) Subscribe(names ...string) error {
+	return s.SubscribeContext(context.Background(), names...)
+}
+
+// SubscribeContext is like Subscribe but uses ctx for the request
+func (s *streamConn) SubscribeContext(ctx context.Context, names ...string) error {
+	s.mu.Lock()
+	n := len(s.names)
+	s.mu.Unlock()
+	if n+len(names) > maxStreamsPerConn {
+		return fmt.Errorf("binance: connection allows at most %d streams", maxStreamsPerConn)
+	}
+	if _, err := s.call(ctx, "SUBSCRIBE", names); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, name := range names {
+		if indexOf(s.names, name) < 0 {
+			s.names = append(s.names, name)
+		}
+	}
+	return nil
+}
+
+// Unsubscribe unsubscribes the connection from streams.
+// Like Subscribe, it requires the stream to be read.
+func (s *streamConn) Unsubscribe(names ...string) error {
+	return s.UnsubscribeContext(context.Background(), names...)
+}
+
+// UnsubscribeContext is like Unsubscribe but uses ctx for the request
+func (s *streamConn) UnsubscribeContext(ctx context.Context, names ...string) error {
+	if _, err := s.call(ctx, "UNSUBSCRIBE", names); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, name := range names {
+		if i := indexOf(s.names, name); i >= 0 {
+			s.names = append(s.names[:i:i], s.names[i+1:]...)
+		}
+	}
+	return nil
+}
+
+// ListSubscriptions returns streams the connection is subscribed to as reported by Binance.
+// Like Subscribe, it requires the stream to be read.
+func (s *streamConn) ListSubscriptions() ([]string, error) {
+	return s.ListSubscriptionsContext(context.Background())
+}
+
+// ListSubscriptionsContext is like ListSubscriptions but uses ctx for the request
+func (s *streamConn) ListSubscriptionsContext(ctx context.Context) ([]string, error) {
+	result, err := s.call(ctx, "LIST_SUBSCRIPTIONS", nil)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	if err := json.Unmarshal(result, &names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+func indexOf(list []string, s string) int {
+	for i, v := range list {
+		if v == s {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,118 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// timeSyncSamples is how many server time samples are taken by a single sync
+const timeSyncSamples = 3
+
+// timeSync keeps offset of Binance server clock from the local clock
+type timeSync struct {
+	mu       sync.Mutex
+	offset   time.Duration
+	latency  time.Duration
+	syncTime time.Time
+}
+
+func (t *timeSync) now() time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return time.Now().Add(t.offset)
+}
+
+// sampleOffset returns offset of server time received after round trip rtt that started at start.
+// Server time is assumed to be taken in the middle of the round trip.
+func sampleOffset(start time.Time, rtt time.Duration, server time.Time) time.Duration {
+	return server.Sub(start.Add(rtt / 2))
+}
+
+// SyncTime measures offset of Binance server clock from the local clock. The offset
+// is added to the timestamp of every signed request, so they are not rejected when
+// the local clock drifts. Several samples are taken and the one with the shortest
+// round trip is used.
+func (c *Client) SyncTime() error {
+	return c.SyncTimeContext(context.Background())
+}
+
+// SyncTimeContext is like SyncTime but uses ctx for the requests
+func (c *Client) SyncTimeContext(ctx context.Context) error {
+	var offset, latency time.Duration
+	for i := 0; i < timeSyncSamples; i++ {
+		start := time.Now()
+		server, err := c.GetServerTimeContext(ctx)
+		if err != nil {
+			return err
+		}
+		rtt := time.Since(start)
+		if i == 0 || rtt < latency {
+			offset, latency = sampleOffset(start, rtt, *server), rtt
+		}
+	}
+	c.clock.mu.Lock()
+	defer c.clock.mu.Unlock()
+	c.clock.offset = offset
+	c.clock.latency = latency
+	c.clock.syncTime = time.Now()
+	return nil
+}
+
+// StartTimeSync syncs time and keeps syncing it every interval until ctx is canceled.
+// Failed periodic syncs keep the previous offset.
+func (c *Client) StartTimeSync(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return errors.New("binance: time sync interval must be positive")
+	}
+	if err := c.SyncTimeContext(ctx); err != nil {
+		return err
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.SyncTimeContext(ctx)
+			}
+		}
+	}()
+	return nil
+}
+
+// TimeOffset returns how much Binance server clock is ahead of the local clock
+func (c *Client) TimeOffset() time.Duration {
+	c.clock.mu.Lock()
+	defer c.clock.mu.Unlock()
+	return c.clock.offset
+}
+
+// Latency returns round trip time of the server time request used by the last sync
+func (c *Client) Latency() time.Duration {
+	c.clock.mu.Lock()
+	defer c.clock.mu.Unlock()
+	return c.clock.latency
+}
+
+// LastTimeSync returns when time was last synced or zero time when it never was
+func (c *Client) LastTimeSync() time.Time {
+	c.clock.mu.Lock()
+	defer c.clock.mu.Unlock()
+	return c.clock.syncTime
+}
+
+// resyncOnTimestampError syncs time and sends signed r again when it was rejected
+// because its timestamp was outside of the receive window
+func (c *Client) resyncOnTimestampError(ctx context.Context, r *request, err error, send func() error) error {
+	if r.sec != secSigned || !IsInvalidTimestamp(err) {
+		return err
+	}
+	if c.SyncTimeContext(ctx) != nil {
+		return err
+	}
+	return send()
+}
@@ -0,0 +1,60 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestResyncOnTimestampError(t *testing.T) {
+	const offset = time.Hour
+	var mu sync.Mutex
+	var timestamps []time.Time
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case addrServerTime:
+			fmt.Fprintf(w, `{"serverTime":%s}`, formatTime(time.Now().Add(offset)))
+		case addrAccount:
+			ms, _ := strconv.ParseInt(r.URL.Query().Get("timestamp"), 10, 64)
+			timestamps = append(timestamps, msTime(ms))
+			if len(timestamps) == 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"code":-1021,"msg":"Timestamp for this request was 1000ms ahead of the server's time."}`))
+				return
+			}
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer srv.Close()
+	c := NewClient(WithBaseURL(srv.URL), WithCredentials("key", "secret"))
+	if _, err := c.GetAccount(); err != nil {
+		t.Fatal(err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(timestamps) != 2 {
+		t.Fatalf("sent %d requests, want 2", len(timestamps))
+	}
+	if d := timestamps[1].Sub(timestamps[0]); d < offset-time.Minute || d > offset+time.Minute {
+		t.Errorf("resent timestamp moved by %v, want about %v", d, offset)
+	}
+	if d := c.TimeOffset(); d < offset-time.Minute || d > offset+time.Minute {
+		t.Errorf("got time offset %v, want about %v", d, offset)
+	}
+}
+
+func TestStartTimeSyncInterval(t *testing.T) {
+	c := NewClient(WithBaseURL("http://127.0.0.1:0"))
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if err := c.StartTimeSync(context.Background(), interval); err == nil {
+			t.Errorf("StartTimeSync(%v) did not fail", interval)
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// listenKeyKeepaliveInterval is how often listen key is kept alive.
+// Binance expires listen keys 60 minutes after the last keepalive.
+const listenKeyKeepaliveInterval = 30 * time.Minute
+
+// ErrStreamClosed is returned when reading from a closed stream
+var ErrStreamClosed = errors.New("binance: stream closed")
+
+// CreateListenKey starts new user data stream and returns its listen key
+func (c *Client) CreateListenKey() (string, error) {
+	return c.CreateListenKeyContext(context.Background())
+}
+
+// CreateListenKeyContext is like CreateListenKey but uses ctx for the request
+func (c *Client) CreateListenKeyContext(ctx context.Context) (string, error) {
+	var reply struct {
+		ListenKey string `json:"listenKey"`
+	}
+	r := &request{method: http.MethodPost, path: addrUserDataStream, sec: secAPIKey}
+	if err := c.do(ctx, r, &reply); err != nil {
+		return "", err
+	}
+	return reply.ListenKey, nil
+}
+
+// KeepAliveListenKey extends listen key validity for 60 minutes
+func (c *Client) KeepAliveListenKey(listenKey string) error {
+	return c.KeepAliveListenKeyContext(context.Background(), listenKey)
+}
+
+// KeepAliveListenKeyContext is like KeepAliveListenKey but uses ctx for the request
+func (c *Client) KeepAliveListenKeyContext(ctx context.Context, listenKey string) error {
+	r := &request{method: http.MethodPut, path: addrUserDataStream, params: params{"listenKey": listenKey}, sec: secAPIKey}
+	return c.do(ctx, r, nil)
+}
+
+// CloseListenKey closes user data stream
+func (c *Client) CloseListenKey(listenKey string) error {
+	return c.CloseListenKeyContext(context.Background(), listenKey)
+}
+
+// CloseListenKeyContext is like CloseListenKey but uses ctx for the request
+func (c *Client) CloseListenKeyContext(ctx context.Context, listenKey string) error {
+	r := &request{method: http.MethodDelete, path: addrUserDataStream, params: params{"listenKey": listenKey}, sec: secAPIKey}
+	return c.do(ctx, r, nil)
+}
+
+type userDataResult struct {
+	event UserDataEvent
+	err   error
+}
+
+// UserDataStream delivers account, balance and order updates.
+// The stream keeps its listen key alive and, when the key expires,
+// obtains a fresh key and reconnects. With WithStreamReconnect a dropped
+// connection is dialed again with the same listen key and Read reports
+// the gap with *ReconnectedError.
+type UserDataStream struct {
+	client *Client
+	parent context.Context
+	ctx    context.Context
+	cancel context.CancelFunc
+	events chan userDataResult
+	wg     sync.WaitGroup
+
+	mu        sync.Mutex
+	listenKey string
+	socket    *websocket.Conn
+	state     StreamState
+	renew     bool
+}
+
+// OpenUserDataStream creates listen key and opens user data stream websocket
+func (c *Client) OpenUserDataStream() (*UserDataStream, error) {
+	return c.OpenUserDataStreamContext(context.Background())
+}
+
+// OpenUserDataStreamContext is like OpenUserDataStream but uses ctx for dialing.
+// Canceling ctx closes the stream.
+func (c *Client) OpenUserDataStreamContext(ctx context.Context) (*UserDataStream, error) {
+	parent := ctx
+	ctx, cancel := context.WithCancel(parent)
+	s := &UserDataStream{
+		client: c,
+		parent: parent,
+		ctx:    ctx,
+		cancel: cancel,
+		events: make(chan userDataResult),
+	}
+	if err := s.connect(); err != nil {
+		cancel()
+		return nil, err
+	}
+	s.wg.Add(2)
+	go s.run()
+	go s.keepalive()
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		s.socket.Close()
+		s.mu.Unlock()
+		s.wg.Wait()
+		close(s.events)
+	}()
+	return s, nil
+}
+
+// ListenKey returns listen key currently used by the stream
+func (s *UserDataStream) ListenKey() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.listenKey
+}
+
+// State returns state of the stream connection. The stream is reconnecting
+// while it dials again after a dropped connection or renews its listen key.
+func (s *UserDataStream) State() StreamState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ctx.Err() != nil {
+		return StreamClosed
+	}
+	return s.state
+}
+
+// Read returns next event
+func (s *UserDataStream) Read() (UserDataEvent, error) {
+	res, ok := <-s.events
+	if !ok {
+		return nil, contextError(s.parent, ErrStreamClosed)
+	}
+	return res.event, res.err
+}
+
+// Close closes the stream websocket and its listen key
+func (s *UserDataStream) Close() error {
+	s.cancel()
+	s.wg.Wait()
+	return s.client.CloseListenKey(s.ListenKey())
+}
+
+// connect creates new listen key and dials its websocket
+func (s *UserDataStream) connect() error {
+	key, err := s.client.CreateListenKeyContext(s.ctx)
+	if err != nil {
+		return err
+	}
+	socket, err := s.client.connectWebsocket(s.ctx, key)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.socket != nil {
+		s.socket.Close()
+	}
+	s.listenKey = key
+	s.socket = socket
+	s.state = StreamConnected
+	s.renew = false
+	if s.ctx.Err() != nil {
+		socket.Close()
+	}
+	return nil
+}
+
+// reconnect replaces expired listen key with a fresh one
+func (s *UserDataStream) reconnect() bool {
+	s.setState(StreamReconnecting)
+	if err := s.connect(); err != nil {
+		s.send(nil, err)
+		return false
+	}
+	return s.send(&ListenKeyRenewedEvent{ListenKey: s.ListenKey()}, nil)
+}
+
+// redial dials current listen key again after the connection dropped with cause
+func (s *UserDataStream) redial(cause error) error {
+	s.setState(StreamReconnecting)
+	p := s.client.reconnect
+	start := time.Now()
+	err := cause
+	for attempt := 0; p.MaxAttempts == 0 || attempt < p.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(backoffDelay(p.BaseDelay, p.MaxDelay, attempt-1))
+			select {
+			case <-s.ctx.Done():
+				timer.Stop()
+				return s.ctx.Err()
+			case <-timer.C:
+			}
+		}
+		var socket *websocket.Conn
+		if socket, err = s.client.connectWebsocket(s.ctx, s.ListenKey()); err != nil {
+			continue
+		}
+		s.mu.Lock()
+		s.socket.Close()
+		s.socket = socket
+		s.state = StreamConnected
+		if s.ctx.Err() != nil {
+			socket.Close()
+		}
+		s.mu.Unlock()
+		return &ReconnectedError{Err: cause, Attempts: attempt + 1, Downtime: time.Since(start)}
+	}
+	return err
+}
+
+func (s *UserDataStream) setState(state StreamState) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+}
+
+// send delivers event to Read, reporting false when the stream is closed
+func (s *UserDataStream) send(event UserDataEvent, err error) bool {
+	select {
+	case s.events <- userDataResult{event, err}:
+		return true
+	case <-s.ctx.Done():
+		return false
+	}
+}
+
+// run reads the socket until the stream is closed or fails
+func (s *UserDataStream) run() {
+	defer s.wg.Done()
+	defer s.cancel()
+	for {
+		s.mu.Lock()
+		socket := s.socket
+		s.mu.Unlock()
+
+		data, err := s.client.readSocket(socket)
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			s.mu.Lock()
+			renew := s.renew
+			s.mu.Unlock()
+			if renew {
+				if s.reconnect() {
+					continue
+				}
+				return
+			}
+			if s.client.reconnect != nil {
+				err = s.redial(err)
+				if _, ok := err.(*ReconnectedError); ok && s.send(nil, err) {
+					continue
+				}
+				if s.ctx.Err() != nil {
+					return
+				}
+			}
+			s.send(nil, err)
+			return
+		}
+
+		event, err := parseUserDataEvent(data)
+		if !s.send(event, err) {
+			return
+		}
+		if _, expired := event.(*ListenKeyExpiredEvent); expired && !s.reconnect() {
+			return
+		}
+	}
+}
+
+// keepalive periodically extends listen key validity
+func (s *UserDataStream) keepalive() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(listenKeyKeepaliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		key := s.ListenKey()
+		err := s.client.KeepAliveListenKeyContext(s.ctx, key)
+		if err == nil {
+			continue
+		}
+		if s.ctx.Err() != nil {
+			return
+		}
+		if !s.send(&KeepaliveFailedEvent{ListenKey: key, Err: err}, nil) {
+			return
+		}
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.Code == CodeInvalidListenKey {
+			// Closing the socket makes run obtain a fresh key
+			s.mu.Lock()
+			if s.listenKey == key {
+				s.renew = true
+				s.socket.Close()
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+// parseUserDataEvent decodes event based on its type.
+// Events of unknown type are returned as *RawUserDataEvent.
+func parseUserDataEvent(data []byte) (UserDataEvent, error) {
+	raw := &RawUserDataEvent{Data: json.RawMessage(data)}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return nil, err
+	}
+	var event UserDataEvent
+	switch raw.EventType {
+	case "executionReport":
+		event = new(ExecutionReport)
+	case "outboundAccountPosition":
+		event = new(AccountPositionUpdate)
+	case "balanceUpdate":
+		event = new(BalanceUpdate)
+	case "listStatus":
+		event = new(ListStatusEvent)
+	case "listenKeyExpired":
+		event = new(ListenKeyExpiredEvent)
+	default:
+		return raw, nil
+	}
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,228 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestParseUserDataEvent(t *testing.T) {
+	// Events from Binance user data stream documentation
+	tests := []struct {
+		name string
+		data string
+		want UserDataEvent
+	}{
+		{
+			name: "execution report",
+			data: `{"e":"executionReport","E":1499405658658,"s":"ETHBTC","c":"mUvoqJxFIILMdfAW5iGSOW","S":"BUY",
+				"o":"LIMIT","f":"GTC","q":"1.00000000","p":"0.10264410","P":"0.00000000","F":"0.00000000","g":-1,
+				"C":"","x":"NEW","X":"NEW","r":"NONE","i":4293153,"l":"0.00000000","z":"0.00000000",
+				"L":"0.00000000","n":"0","N":null,"T":1499405658657,"t":-1,"v":3,"I":8641984,"w":true,
+				"m":false,"M":false,"O":1499405658657,"Z":"0.00000000","Y":"0.00000000","Q":"0.00000000",
+				"W":1499405658657,"V":"NONE"}`,
+			want: &ExecutionReport{
+				EventType:       "executionReport",
+				EventTime:       1499405658658,
+				Symbol:          "ETHBTC",
+				ClientOrderID:   "mUvoqJxFIILMdfAW5iGSOW",
+				Side:            BuyOrder,
+				Type:            LimitOrder,
+				TimeInForce:     GoodTillCanceled,
+				Quantity:        1,
+				Price:           0.1026441,
+				OrderListID:     -1,
+				ExecutionType:   "NEW",
+				Status:          "NEW",
+				RejectReason:    "NONE",
+				OrderID:         4293153,
+				TransactionTime: 1499405658657,
+				TradeID:         -1,
+				IsWorking:       true,
+				CreationTime:    1499405658657,
+				WorkingTime:     1499405658657,
+				IgnoreI:         float64(8641984),
+				IgnoreM:         false,
+			},
+		},
+		{
+			name: "account position",
+			data: `{"e":"outboundAccountPosition","E":1564034571105,"u":1564034571073,
+				"B":[{"a":"ETH","f":"10000.000000","l":"0.000000"}]}`,
+			want: &AccountPositionUpdate{
+				EventType:      "outboundAccountPosition",
+				EventTime:      1564034571105,
+				LastUpdateTime: 1564034571073,
+				Balances:       []PositionBalance{{Asset: "ETH", Free: 10000}},
+			},
+		},
+		{
+			name: "balance update",
+			data: `{"e":"balanceUpdate","E":1573200697110,"a":"BTC","d":"100.00000000","T":1573200697068}`,
+			want: &BalanceUpdate{
+				EventType: "balanceUpdate",
+				EventTime: 1573200697110,
+				Asset:     "BTC",
+				Delta:     100,
+				ClearTime: 1573200697068,
+			},
+		},
+		{
+			name: "list status",
+			data: `{"e":"listStatus","E":1564035303637,"s":"ETHBTC","g":2,"c":"OCO","l":"EXEC_STARTED",
+				"L":"EXECUTING","r":"NONE","C":"F4QN4G8DlFATFlIUQ0cjdD","T":1564035303625,
+				"O":[{"s":"ETHBTC","i":17,"c":"AJYsMjErWJesZvqlJCTUgL"},{"s":"ETHBTC","i":18,"c":"bfYPSQdLoqAJeNrOr9adzq"}]}`,
+			want: &ListStatusEvent{
+				EventType:         "listStatus",
+				EventTime:         1564035303637,
+				Symbol:            "ETHBTC",
+				OrderListID:       2,
+				ContingencyType:   ContingencyOCO,
+				ListStatusType:    ListStatusExecStarted,
+				ListOrderStatus:   ListOrderStatusExecuting,
+				RejectReason:      "NONE",
+				ListClientOrderID: "F4QN4G8DlFATFlIUQ0cjdD",
+				TransactionTime:   1564035303625,
+				Orders: []ListStatusOrder{
+					{Symbol: "ETHBTC", OrderID: 17, ClientOrderID: "AJYsMjErWJesZvqlJCTUgL"},
+					{Symbol: "ETHBTC", OrderID: 18, ClientOrderID: "bfYPSQdLoqAJeNrOr9adzq"},
+				},
+			},
+		},
+		{
+			name: "listen key expired",
+			data: `{"e":"listenKeyExpired","E":1576653824250,"listenKey":"OfYGbUzi3PraNagEkdKuFwUHn48brFsItTdsuiIXrucEvD0rhRXZ7I6URWfE8YE8"}`,
+			want: &ListenKeyExpiredEvent{
+				EventType: "listenKeyExpired",
+				EventTime: 1576653824250,
+				ListenKey: "OfYGbUzi3PraNagEkdKuFwUHn48brFsItTdsuiIXrucEvD0rhRXZ7I6URWfE8YE8",
+			},
+		},
+		{
+			name: "unknown",
+			data: `{"e":"externalLockUpdate","E":1581557507324}`,
+			want: &RawUserDataEvent{
+				EventType: "externalLockUpdate",
+				EventTime: 1581557507324,
+				Data:      []byte(`{"e":"externalLockUpdate","E":1581557507324}`),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := parseUserDataEvent([]byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(event, tt.want) {
+				t.Errorf("got %+v, want %+v", event, tt.want)
+			}
+		})
+	}
+}
+
+// userDataServer serves listen key requests and user data stream websockets.
+// The first connection sends one event and drops, the next ones send one event
+// and stay open.
+func userDataServer(created *int32) *httptest.Server {
+	var upgrader websocket.Upgrader
+	var dials int32
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == addrUserDataStream {
+			if r.Method == http.MethodPost {
+				atomic.AddInt32(created, 1)
+			}
+			w.Write([]byte(`{"listenKey":"abc"}`))
+			return
+		}
+		if r.URL.Path != "/ws/abc" {
+			http.NotFound(w, r)
+			return
+		}
+		socket, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer socket.Close()
+		n := atomic.AddInt32(&dials, 1)
+		socket.WriteMessage(websocket.TextMessage,
+			[]byte(`{"e":"balanceUpdate","E":1573200697110,"a":"BTC","d":"`+strconv.Itoa(int(n))+`","T":1573200697068}`))
+		if n == 1 {
+			return
+		}
+		for {
+			if _, _, err := socket.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+func TestUserDataStreamRedial(t *testing.T) {
+	var created int32
+	srv := userDataServer(&created)
+	defer srv.Close()
+	c := NewClient(WithBaseURL(srv.URL), WithStreamBaseURL("ws"+strings.TrimPrefix(srv.URL, "http")),
+		WithCredentials("key", "secret"), WithStreamReconnect(ReconnectPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+	s, err := c.OpenUserDataStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if st := s.State(); st != StreamConnected {
+		t.Errorf("got state %s, want %s", st, StreamConnected)
+	}
+
+	wantDelta := func(delta float64) {
+		t.Helper()
+		event, err := s.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u, ok := event.(*BalanceUpdate); !ok || u.Delta != delta {
+			t.Fatalf("got event %+v, want balance update of %v", event, delta)
+		}
+	}
+	wantDelta(1)
+	_, err = s.Read()
+	var reconnected *ReconnectedError
+	if !errors.As(err, &reconnected) {
+		t.Fatalf("got %v, want *ReconnectedError", err)
+	}
+	wantDelta(2)
+	if st := s.State(); st != StreamConnected {
+		t.Errorf("got state %s after redial, want %s", st, StreamConnected)
+	}
+	if n := atomic.LoadInt32(&created); n != 1 || s.ListenKey() != "abc" {
+		t.Errorf("created %d listen keys, using %q; want the same key", n, s.ListenKey())
+	}
+}
+
+func TestUserDataStreamReadAfterClose(t *testing.T) {
+	var created int32
+	srv := userDataServer(&created)
+	defer srv.Close()
+	c := NewClient(WithBaseURL(srv.URL), WithStreamBaseURL("ws"+strings.TrimPrefix(srv.URL, "http")),
+		WithCredentials("key", "secret"))
+	s, err := c.OpenUserDataStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Read(); err != ErrStreamClosed {
+		t.Errorf("got %v, want %v", err, ErrStreamClosed)
+	}
+	if st := s.State(); st != StreamClosed {
+		t.Errorf("got state %s, want %s", st, StreamClosed)
+	}
+}
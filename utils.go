@@ -1,6 +1,10 @@
 package binance
 
-import "strconv"
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
 
 type rawOrderBook struct {
 	LastUpdateID uint64          `json:"lastUpdateId"`
@@ -10,13 +14,24 @@ type rawOrderBook struct {
 
 func parseOrders(v [][]interface{}) ([]Order, error) {
 	orders := make([]Order, len(v))
-	for i, bid := range v {
+	for i, level := range v {
+		if len(level) < 2 {
+			return nil, fmt.Errorf("binance: order book level has %d fields, want 2", len(level))
+		}
+		price, ok := level[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("binance: invalid order book price %v", level[0])
+		}
+		qty, ok := level[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("binance: invalid order book quantity %v", level[1])
+		}
 		var err error
 		var order Order
-		if order.Price, err = strconv.ParseFloat(bid[0].(string), 64); err != nil {
+		if order.Price, err = strconv.ParseFloat(price, 64); err != nil {
 			return nil, err
 		}
-		if order.Quantity, err = strconv.ParseFloat(bid[1].(string), 64); err != nil {
+		if order.Quantity, err = strconv.ParseFloat(qty, 64); err != nil {
 			return nil, err
 		}
 		orders[i] = order
@@ -40,3 +55,34 @@ func parseOrderBook(raw *rawOrderBook) (*OrderBook, error) {
 	book.Asks = asks
 	return book, nil
 }
+
+// formatTime formats t as milliseconds since epoch, the format used by Binance timestamps.
+// Zero time is formatted as empty string.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+// msTime converts milliseconds since epoch to time
+func msTime(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+// formatFloat formats v for a request parameter. Zero is formatted
+// as empty string, so the parameter is left out of the request.
+func formatFloat(v float64) string {
+	if v == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// formatInt formats v for a request parameter, leaving out zero
+func formatInt(v int64) string {
+	if v == 0 {
+		return ""
+	}
+	return strconv.FormatInt(v, 10)
+}
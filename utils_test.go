@@ -0,0 +1,41 @@
+package binance
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseOrders(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []Order
+		err  bool
+	}{
+		{name: "valid", data: `[["0.0024","10"],["0.0025","5.5",[]]]`, want: []Order{{0.0024, 10}, {0.0025, 5.5}}},
+		{name: "short level", data: `[["0.0024"]]`, err: true},
+		{name: "number price", data: `[[0.0024,"10"]]`, err: true},
+		{name: "null quantity", data: `[["0.0024",null]]`, err: true},
+		{name: "invalid price", data: `[["price","10"]]`, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v [][]interface{}
+			if err := json.Unmarshal([]byte(tt.data), &v); err != nil {
+				t.Fatal(err)
+			}
+			orders, err := parseOrders(v)
+			if (err != nil) != tt.err {
+				t.Fatalf("got error %v, want error %v", err, tt.err)
+			}
+			if len(orders) != len(tt.want) {
+				t.Fatalf("got %v, want %v", orders, tt.want)
+			}
+			for i := range orders {
+				if orders[i] != tt.want[i] {
+					t.Errorf("got %v, want %v", orders, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -1,20 +1,98 @@
 package binance
 
 import (
-	"net/url"
+	"context"
+	"errors"
+	"net"
+	"time"
 
 	"github.com/gorilla/websocket"
 )
 
-const binanceWsAddr = "stream.binance.com:9443"
-const binanceWsScheme = "wss"
+// pingWriteTimeout limits how long writing ping or pong frame may take
+const pingWriteTimeout = 10 * time.Second
 
-func connectWebsocket(path string) (*websocket.Conn, error) {
-	socketURL := url.URL{
-		Scheme: binanceWsScheme,
-		Host:   binanceWsAddr,
-		Path:   "ws/" + path,
+// ErrStreamStalled is returned when no frame arrives on a stream within its read timeout,
+// which happens when the connection is half-open. The connection is unusable afterwards.
+var ErrStreamStalled = errors.New("binance: stream stalled, nothing received within read timeout")
+
+// WithStreamReadTimeout makes streams fail with ErrStreamStalled when nothing arrives
+// for d. Every message, ping or pong extends the deadline; the client pings Binance
+// every d/2, so idle streams stay alive as long as the connection works.
+func WithStreamReadTimeout(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.readTimeout = d
+	}
+}
+
+func (c *Client) connectWebsocket(ctx context.Context, path string) (*websocket.Conn, error) {
+	return c.dialWebsocket(ctx, c.streamBaseURL+"/ws/"+path, new(messageLimiter))
+}
+
+// dialWebsocket dials addr and sets up answering pings and read deadline of the socket.
+// Pings and pongs sent to Binance are paced by lim.
+func (c *Client) dialWebsocket(ctx context.Context, addr string, lim *messageLimiter) (*websocket.Conn, error) {
+	socket, _, err := websocket.DefaultDialer.DialContext(ctx, addr, nil)
+	if err != nil {
+		return nil, err
+	}
+	socket.SetPingHandler(func(data string) error {
+		c.extendDeadline(socket)
+		time.Sleep(lim.reserve(time.Now()))
+		err := socket.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(pingWriteTimeout))
+		if err == websocket.ErrCloseSent {
+			return nil
+		}
+		if e, ok := err.(net.Error); ok && e.Temporary() {
+			return nil
+		}
+		return err
+	})
+	if c.readTimeout > 0 {
+		socket.SetPongHandler(func(string) error {
+			c.extendDeadline(socket)
+			return nil
+		})
+		c.extendDeadline(socket)
+		go c.ping(socket, lim)
+	}
+	return socket, nil
+}
+
+func (c *Client) extendDeadline(socket *websocket.Conn) {
+	if c.readTimeout > 0 {
+		socket.SetReadDeadline(time.Now().Add(c.readTimeout))
+	}
+}
+
+// ping pings Binance until the socket is closed
+func (c *Client) ping(socket *websocket.Conn, lim *messageLimiter) {
+	ticker := time.NewTicker(c.readTimeout / 2)
+	defer ticker.Stop()
+	for range ticker.C {
+		time.Sleep(lim.reserve(time.Now()))
+		if err := socket.WriteControl(websocket.PingMessage, nil, time.Now().Add(pingWriteTimeout)); err != nil {
+			return
+		}
+	}
+}
+
+// readSocket reads next message from socket, extending its read deadline.
+// Read timeout is reported as ErrStreamStalled.
+func (c *Client) readSocket(socket *websocket.Conn) ([]byte, error) {
+	c.extendDeadline(socket)
+	_, data, err := socket.ReadMessage()
+	if e, ok := err.(net.Error); ok && e.Timeout() {
+		socket.Close()
+		return nil, ErrStreamStalled
+	}
+	return data, err
+}
+
+// contextError returns ctx error instead of err once ctx is done
+func contextError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
 	}
-	socket, _, err := websocket.DefaultDialer.Dial(socketURL.String(), nil)
-	return socket, err
+	return err
 }
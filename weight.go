@@ -0,0 +1,93 @@
+package binance
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// requestWeight returns REQUEST_WEIGHT cost of r.
+// Unknown endpoints cost 1.
+func requestWeight(r *request) int {
+	p := r.params
+	switch r.path {
+	case addrExchangeInfo:
+		return 20
+	case addrOrderBook:
+		return depthWeight(p["limit"])
+	case addrRecentTradesList, addrOldTradeLookup:
+		return 25
+	case addrAggregatedTrades:
+		return 4
+	case addrKlineCandlestickData:
+		return 2
+	case addrExchangeData24H:
+		if p["symbol"] == "" {
+			return 80
+		}
+		return 2
+	case addrSymbolPriceTicker, addrBookTicker:
+		if p["symbol"] == "" {
+			return 4
+		}
+		return 2
+	case addrOrder, addrOrderList:
+		if r.method == http.MethodGet {
+			return 4
+		}
+		return 1
+	case addrOrderTest:
+		if p["computeCommissionRates"] == "true" {
+			return 20
+		}
+		return 1
+	case addrOpenOrders:
+		if r.method == http.MethodGet && p["symbol"] == "" {
+			return 80
+		}
+		if r.method == http.MethodGet {
+			return 6
+		}
+		return 1
+	case addrOpenOrderList:
+		return 6
+	case addrAllOrders, addrAllOrderList, addrAccount:
+		return 20
+	case addrMyTrades:
+		if p["orderId"] != "" {
+			return 5
+		}
+		return 20
+	case addrUserDataStream:
+		return 2
+	}
+	return 1
+}
+
+// depthWeight returns order book weight for limit; Binance uses limit 100 when not set
+func depthWeight(limit string) int {
+	n, err := strconv.Atoi(limit)
+	if err != nil || n == 0 {
+		n = 100
+	}
+	switch {
+	case n <= 100:
+		return 5
+	case n <= 500:
+		return 25
+	case n <= 1000:
+		return 50
+	}
+	return 250
+}
+
+// isOrderRequest reports whether r counts towards ORDERS rate limits
+func isOrderRequest(r *request) bool {
+	if r.method != http.MethodPost {
+		return false
+	}
+	switch r.path {
+	case addrOrder, addrCancelReplace, addrOrderListOCO, addrOrderListOTO, addrOrderListOTOCO:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+const defaultAPIStreamURL = "wss://ws-api.binance.com:443/ws-api/v3"
+
+// APISession is a websocket API connection.
+// Calls on a session are sent one at a time.
+type APISession struct {
+	client *Client
+	socket *websocket.Conn
+	mu     sync.Mutex
+	nextID uint64
+}
+
+type apiRequest struct {
+	ID     uint64                 `json:"id"`
+	Method string                 `json:"method"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+type apiResponse struct {
+	ID     uint64          `json:"id"`
+	Status int             `json:"status"`
+	Result json.RawMessage `json:"result"`
+	Error  *APIError       `json:"error"`
+}
+
+// OpenAPISession opens websocket API connection
+func (c *Client) OpenAPISession(ctx context.Context) (*APISession, error) {
+	socket, _, err := websocket.DefaultDialer.DialContext(ctx, c.apiStreamURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &APISession{client: c, socket: socket}, nil
+}
+
+// Logon authenticates the session with the client API key and signer,
+// so later calls on the session do not need to be signed.
+// Binance accepts only Ed25519 keys for session logon.
+func (s *APISession) Logon(ctx context.Context) error {
+	if s.client.apiKey == "" || s.client.signer == nil {
+		return ErrMissingCredentials
+	}
+	ts := formatTime(s.client.clock.now())
+	sig, err := s.client.signer.Sign([]byte(encodeParams(params{"apiKey": s.client.apiKey, "timestamp": ts})))
+	if err != nil {
+		return err
+	}
+	p := map[string]interface{}{
+		"apiKey":    s.client.apiKey,
+		"timestamp": json.Number(ts),
+		"signature": sig,
+	}
+	return s.Call(ctx, "session.logon", p, nil)
+}
+
+// Call sends request with given method and parameters and decodes its result into reply.
+// Canceling ctx while the call is in flight closes the session.
+func (s *APISession) Call(ctx context.Context, method string, p map[string]interface{}, reply interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.socket.Close()
+		case <-done:
+		}
+	}()
+
+	s.nextID++
+	id := s.nextID
+	if err := s.socket.WriteJSON(apiRequest{ID: id, Method: method, Params: p}); err != nil {
+		return contextError(ctx, err)
+	}
+	for {
+		var res apiResponse
+		if err := s.socket.ReadJSON(&res); err != nil {
+			return contextError(ctx, err)
+		}
+		if res.ID != id {
+			continue
+		}
+		if res.Error != nil {
+			res.Error.HTTPStatus = res.Status
+			return res.Error
+		}
+		if reply == nil {
+			return nil
+		}
+		return json.Unmarshal(res.Result, reply)
+	}
+}
+
+// Close closes underlying websocket connection
+func (s *APISession) Close() error {
+	return s.socket.Close()
+}